@@ -2,6 +2,7 @@ package netbox
 
 import (
 	"log"
+	"sync"
 
 	api "github.com/digitalocean/go-netbox/netbox"
 	"github.com/digitalocean/go-netbox/netbox/client"
@@ -17,11 +18,26 @@ type Config struct {
 	// The API endpoint. This defaults to http://localhost/api, and can also be
 	// supplied via the NETBOX_ENDPOINT_ADDR environment variable.
 	Endpoint string
+
+	// Comment of the journal entries attached to the objects on create and
+	// update. No entries are attached when it is empty.
+	JournalComment string
+
+	// Kind of the journal entries: info, success, warning or danger.
+	JournalKind string
 }
 
 type ProviderNetboxClient struct {
 	client        *client.NetBox
 	configuration Config
+	// API version reported by netbox, filled on the first raw request. The
+	// resources are applied in parallel, so it is guarded by apiVersionMutex.
+	apiVersion      string
+	apiVersionMutex sync.Mutex
+	// Types of the custom fields by name, fetched from netbox when first
+	// needed and guarded by customFieldMutex.
+	customFieldTypes map[string]string
+	customFieldMutex sync.Mutex
 }
 
 // ProviderNetboxClient is a structure that contains the client connections
@@ -34,8 +50,10 @@ func (c *Config) Client() (interface{}, error) {
 	log.Printf("[DEBUG] config.go Client() AppID: %s", c.AppID)
 	log.Printf("[DEBUG] config.go Client() Endpoint: %s", c.Endpoint)
 	cfg := Config{
-		AppID:    c.AppID,
-		Endpoint: c.Endpoint,
+		AppID:          c.AppID,
+		Endpoint:       c.Endpoint,
+		JournalComment: c.JournalComment,
+		JournalKind:    c.JournalKind,
 	}
 	log.Printf("[DEBUG] Initializing Netbox controllers")
 	// sess := session.NewSession(cfg)
@@ -0,0 +1,32 @@
+package netbox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// setConnectionFields sets the connection state of a device component that
+// can be cabled from an API answer: its cable and the endpoint at the far end
+// of its path.
+func setConnectionFields(d *schema.ResourceData, component map[string]interface{}) {
+	endpointType, endpointID, connected := connectedEndpoint(component)
+	d.Set("cable_id", nestedID(component["cable"]))
+	d.Set("connected_endpoint_type", endpointType)
+	d.Set("connected_endpoint_id", endpointID)
+	d.Set("connected", connected)
+}
+
+// connectedEndpoint returns the type and the ID of the endpoint at the far end
+// of the path of a component, and whether the path is complete. Netbox 3.0+
+// sends a list of endpoints, of which the first one is used.
+func connectedEndpoint(component map[string]interface{}) (string, int, bool) {
+	if endpoints, ok := component["connected_endpoints"]; ok {
+		return stringValue(component["connected_endpoints_type"]),
+			nestedID(firstTraceObject(endpoints)),
+			boolValue(component["connected_endpoints_reachable"])
+	}
+	connected := component["connected_endpoint"] != nil
+	if status, ok := component["connection_status"].(map[string]interface{}); ok {
+		connected = boolValue(status["value"])
+	}
+	return stringValue(component["connected_endpoint_type"]), nestedID(component["connected_endpoint"]), connected
+}
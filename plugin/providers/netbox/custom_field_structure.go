@@ -1,9 +1,13 @@
 package netbox
 
 import (
-	// "fmt"
-	// "reflect"
+	"encoding/json"
+	"fmt"
+	"log"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -24,3 +28,289 @@ func customFieldFilterSchema(conflicts []string) *schema.Schema {
 		},
 	}
 }
+
+// customFieldsSchema returns the bare schema of the custom_fields attribute.
+// The values are strings, converted to the type of each field when sent to
+// netbox. flattenCustomFields keeps the encoding of the values in the state,
+// so an equivalent answer like 1 for "1.0" in a decimal field causes no diff.
+func customFieldsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeMap,
+	}
+}
+
+// netboxCustomFieldTypes returns the type of the custom fields defined on
+// netbox, by name. The definitions are read once and kept until a
+// netbox_custom_field changes them. Netbox before 2.10 does not expose them,
+// and an empty map is returned.
+func netboxCustomFieldTypes(meta interface{}) (map[string]string, error) {
+	c := meta.(*ProviderNetboxClient)
+	c.customFieldMutex.Lock()
+	defer c.customFieldMutex.Unlock()
+	if c.customFieldTypes != nil {
+		return c.customFieldTypes, nil
+	}
+	types := map[string]string{}
+	if netboxVersionAtLeast(meta, "2.10") {
+		fields, err := netboxAPIList(meta, "extras/custom-fields/", nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			types[stringValue(f["name"])] = choiceValue(f["type"])
+		}
+	}
+	c.customFieldTypes = types
+	return types, nil
+}
+
+// resetCustomFieldTypes forgets the custom field definitions, so that they
+// are read again on the next use.
+func resetCustomFieldTypes(meta interface{}) {
+	c := meta.(*ProviderNetboxClient)
+	c.customFieldMutex.Lock()
+	c.customFieldTypes = nil
+	c.customFieldMutex.Unlock()
+}
+
+// customFieldTypesOf returns the custom field definitions for the fields
+// names. They are read again when one of the names is unknown, as the field
+// may have been created since they were read.
+func customFieldTypesOf(meta interface{}, fields map[string]interface{}) (map[string]string, error) {
+	types, err := netboxCustomFieldTypes(meta)
+	if err != nil {
+		return nil, err
+	}
+	for k := range fields {
+		if _, ok := types[k]; !ok {
+			resetCustomFieldTypes(meta)
+			return netboxCustomFieldTypes(meta)
+		}
+	}
+	return types, nil
+}
+
+// expandCustomFields returns the custom_fields attribute as sent to netbox,
+// each value converted to the type of its field. The values were checked at
+// plan time by validateCustomFields, so a value that can not be converted is
+// sent as it is and reported by netbox.
+func expandCustomFields(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	fields := d.Get("custom_fields").(map[string]interface{})
+	types, err := customFieldTypesOf(meta, fields)
+	if err != nil {
+		log.Printf("[DEBUG] Could not read the custom fields: %v\n", err)
+	}
+	cf := map[string]interface{}{}
+	for k, v := range fields {
+		cf[k] = v
+		if fieldType, ok := types[k]; ok {
+			if value, err := expandCustomFieldValue(fieldType, v.(string)); err == nil {
+				cf[k] = value
+			}
+		}
+	}
+	return cf
+}
+
+// flattenCustomFields converts the custom fields of an API answer into the
+// map of strings kept in the state, each value converted with the type of its
+// field. A value of the state equivalent to the answer for the type of its
+// field is kept as it is. Empty fields are left out.
+func flattenCustomFields(d *schema.ResourceData, meta interface{}, v interface{}) map[string]interface{} {
+	current, _ := d.Get("custom_fields").(map[string]interface{})
+	return flattenCustomFieldsKeeping(meta, v, current)
+}
+
+// flattenCustomFieldsKeeping is flattenCustomFields with the current values
+// of the state.
+func flattenCustomFieldsKeeping(meta interface{}, v interface{}, current map[string]interface{}) map[string]interface{} {
+	cf := map[string]interface{}{}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return cf
+	}
+	types, err := customFieldTypesOf(meta, m)
+	if err != nil {
+		log.Printf("[DEBUG] Could not read the custom fields: %v\n", err)
+	}
+	for k, value := range m {
+		if value == nil {
+			continue
+		}
+		fieldType, ok := types[k]
+		if !ok {
+			fieldType = customFieldValueType(value)
+		}
+		cf[k] = flattenCustomFieldValue(fieldType, value)
+		if s, isString := current[k].(string); isString && ok && equivalentCustomFieldValue(fieldType, s, value) {
+			cf[k] = s
+		}
+	}
+	return cf
+}
+
+// equivalentCustomFieldValue tells if the string value of a custom field is
+// the same as the value of an API answer for the type of the field, like "1.0"
+// and 1 for a decimal field. The text fields are only equivalent when equal.
+func equivalentCustomFieldValue(fieldType string, value string, api interface{}) bool {
+	expanded, err := expandCustomFieldValue(fieldType, value)
+	if err != nil {
+		return false
+	}
+	// The round trip gives the expanded value the types of an API answer.
+	b, err := json.Marshal(expanded)
+	if err != nil {
+		return false
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return false
+	}
+	return flattenCustomFieldValue(fieldType, decoded) == flattenCustomFieldValue(fieldType, api)
+}
+
+// customFieldValueType guesses the type of a custom field from the shape of
+// its value in an API answer, for the fields without a known definition, like
+// on netbox before 2.10.
+func customFieldValueType(v interface{}) string {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if _, ok := value["id"]; ok {
+			return "object"
+		}
+		return "json"
+	case []interface{}:
+		objects, names := 0, 0
+		for _, item := range value {
+			switch i := item.(type) {
+			case string:
+				names++
+			case map[string]interface{}:
+				if _, ok := i["id"]; ok {
+					objects++
+				}
+			}
+		}
+		switch {
+		case len(value) > 0 && objects == len(value):
+			return "multiobject"
+		case names == len(value):
+			return "multiselect"
+		}
+		return "json"
+	}
+	return "text"
+}
+
+// validateCustomFields checks at plan time that the values of the custom
+// fields defined on netbox can be converted to the type of the fields.
+// It is called by the CustomizeDiff of the resources with custom_fields.
+func validateCustomFields(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("custom_fields") {
+		return nil
+	}
+	fields := d.Get("custom_fields").(map[string]interface{})
+	types, err := customFieldTypesOf(meta, fields)
+	if err != nil || len(types) == 0 {
+		// Without the definitions netbox validates the values itself.
+		return err
+	}
+	for k, v := range fields {
+		fieldType, ok := types[k]
+		if !ok {
+			// The field may be created by a netbox_custom_field of the same
+			// apply, so it is left to netbox.
+			log.Printf("[DEBUG] custom_fields: %q is not a custom field of netbox yet\n", k)
+			continue
+		}
+		if _, err := expandCustomFieldValue(fieldType, v.(string)); err != nil {
+			return fmt.Errorf("custom_fields: %q is not a valid %s value for %q: %v", v, fieldType, k, err)
+		}
+	}
+	return nil
+}
+
+// customFieldTypes are the types of the netbox custom fields.
+var customFieldTypes = []string{
+	"text", "longtext", "integer", "decimal", "boolean", "date", "datetime",
+	"url", "json", "select", "multiselect", "object", "multiobject",
+}
+
+// expandCustomFieldValue converts the string value of a custom field into the
+// JSON type expected by netbox for the type of the field. The values of the
+// multiselect and multiobject fields are separated by commas.
+func expandCustomFieldValue(fieldType string, value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	switch fieldType {
+	case "integer", "object":
+		return strconv.Atoi(value)
+	case "decimal":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "date":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, err
+		}
+	case "json":
+		var v interface{}
+		err := json.Unmarshal([]byte(value), &v)
+		return v, err
+	case "multiselect":
+		items := []string{}
+		for _, s := range strings.Split(value, ",") {
+			items = append(items, strings.TrimSpace(s))
+		}
+		return items, nil
+	case "multiobject":
+		ids := []int{}
+		for _, s := range strings.Split(value, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+	return value, nil
+}
+
+// flattenCustomFieldValue converts the value of a custom field of an API
+// answer into its string representation, the opposite of
+// expandCustomFieldValue.
+func flattenCustomFieldValue(fieldType string, v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	switch fieldType {
+	case "json":
+		b, _ := json.Marshal(v)
+		return string(b)
+	case "object":
+		return idFromAPI(v)
+	case "multiselect", "multiobject":
+		items := []string{}
+		if l, ok := v.([]interface{}); ok {
+			for _, item := range l {
+				if fieldType == "multiobject" {
+					items = append(items, idFromAPI(item))
+				} else {
+					items = append(items, stringValue(item))
+				}
+			}
+		}
+		return strings.Join(items, ",")
+	}
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	}
+	return fmt.Sprintf("%v", v)
+}
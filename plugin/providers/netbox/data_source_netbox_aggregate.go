@@ -0,0 +1,136 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxAggregate() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxAggregateRead,
+		Schema: dataSourceAggregateSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxAggregateRead(d *schema.ResourceData, meta interface{}) error {
+	prefix := d.Get("prefix").(string)
+	if prefix == "" {
+		return errors.New("No valid combination of parameters found - need prefix")
+	}
+	// The aggregates list has no exact prefix filter, so the search is
+	// narrowed by q and the exact match is done here.
+	query := url.Values{}
+	query.Set("q", prefix)
+	if v, ok := d.GetOk("rir_id"); ok {
+		query.Set("rir_id", strconv.Itoa(v.(int)))
+	}
+	results, err := netboxAPIList(meta, "ipam/aggregates/", query)
+	if err != nil {
+		log.Printf("erro na chamada do GET aggregates\n")
+		log.Printf("Err: %v\n", err)
+		return err
+	}
+	var found []map[string]interface{}
+	for _, result := range results {
+		if stringValue(result["prefix"]) == prefix {
+			found = append(found, result)
+		}
+	}
+	if len(found) == 0 {
+		return errors.New("Aggregate not found")
+	} else if len(found) > 1 {
+		return fmt.Errorf("More than one Aggregate found with prefix %v", prefix)
+	}
+	d.SetId(idFromAPI(found[0]["id"]))
+	setAggregateFields(d, meta, found[0])
+	return nil
+}
+
+// setAggregateFields sets the attributes of a netbox_aggregate from an API
+// answer.
+func setAggregateFields(d *schema.ResourceData, meta interface{}, aggregate map[string]interface{}) {
+	d.Set("prefix", stringValue(aggregate["prefix"]))
+	d.Set("rir_id", nestedID(aggregate["rir"]))
+	d.Set("date_added", stringValue(aggregate["date_added"]))
+	d.Set("description", stringValue(aggregate["description"]))
+	d.Set("tags", flattenTags(aggregate["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, aggregate["custom_fields"]))
+	d.Set("created", stringValue(aggregate["created"]))
+	d.Set("last_updated", stringValue(aggregate["last_updated"]))
+}
+
+// validateDate checks that a value is a date like 2018-12-31.
+func validateDate(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse("2006-01-02", v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a date like 2018-12-31: %v", k, err))
+	}
+	return
+}
+
+func bareAggregateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"prefix": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"rir_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"date_added": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceAggregateSchema() map[string]*schema.Schema {
+	s := bareAggregateSchema()
+	for k, v := range s {
+		switch k {
+		case "prefix", "rir_id":
+			v.Required = true
+		case "date_added":
+			v.Optional = true
+			v.ValidateFunc = validateDate
+		case "description", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceAggregateSchema returns the schema for the netbox_aggregate data
+// source, searched by prefix and optionally by rir_id.
+func dataSourceAggregateSchema() map[string]*schema.Schema {
+	s := bareAggregateSchema()
+	for k, v := range s {
+		switch k {
+		case "prefix":
+			v.Required = true
+		case "rir_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,118 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// dataSourceNetboxCableTrace traces the cable path from a termination, like
+// an interface, to its far end.
+func dataSourceNetboxCableTrace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxCableTraceRead,
+		Schema: map[string]*schema.Schema{
+			"termination_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "dcim.interface",
+				ValidateFunc: validation.StringInSlice(cableTraceTypes, false),
+			},
+			"termination_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			// Cables of the path, from the near end to the far end.
+			"cable_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			// Far end of the path, empty when the path is not complete.
+			"far_end_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"far_end_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"far_end_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Device of the far end, 0 for a circuit termination or a power
+			// feed.
+			"far_end_device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// cableTraceTypes are the types of the terminations netbox can trace, the
+// path endpoints. Front and rear ports and circuit terminations are in the
+// middle of the paths and have no trace.
+var cableTraceTypes = []string{
+	"dcim.interface", "dcim.consoleport", "dcim.consoleserverport",
+	"dcim.powerport", "dcim.poweroutlet", "dcim.powerfeed",
+}
+
+func dataSourceNetboxCableTraceRead(d *schema.ResourceData, meta interface{}) error {
+	terminationType := d.Get("termination_type").(string)
+	id := strconv.Itoa(d.Get("termination_id").(int))
+	path, ok := cableTerminationPaths[terminationType]
+	if !ok {
+		return fmt.Errorf("Can not trace a %s", terminationType)
+	}
+	var segments [][]interface{}
+	if err := netboxAPIRequest(meta, "GET", path+id+"/trace/", nil, &segments); err != nil {
+		return err
+	}
+	cables := []int{}
+	var farEnd map[string]interface{}
+	for _, segment := range segments {
+		if len(segment) != 3 {
+			continue
+		}
+		if cable := nestedID(segment[1]); cable != 0 {
+			cables = append(cables, cable)
+		}
+		farEnd = firstTraceObject(segment[2])
+	}
+	d.SetId(terminationType + "/" + id)
+	d.Set("cable_ids", cables)
+	d.Set("far_end_type", cableTerminationType(stringValue(farEnd["url"])))
+	d.Set("far_end_id", nestedID(farEnd))
+	d.Set("far_end_name", stringValue(farEnd["name"]))
+	d.Set("far_end_device_id", nestedID(farEnd["device"]))
+	return nil
+}
+
+// firstTraceObject returns the object of an end of a trace segment. Netbox
+// 3.0+ sends a list of objects for each end, of which the first one is used.
+func firstTraceObject(v interface{}) map[string]interface{} {
+	if l, ok := v.([]interface{}); ok {
+		if len(l) == 0 {
+			return nil
+		}
+		v = l[0]
+	}
+	o, _ := v.(map[string]interface{})
+	return o
+}
+
+// cableTerminationType returns the type of a termination from its API URL,
+// like "http://netbox/api/dcim/interfaces/1/".
+func cableTerminationType(url string) string {
+	for terminationType, path := range cableTerminationPaths {
+		if strings.Contains(url, "/api/"+path) {
+			return terminationType
+		}
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// circuitStatusLegacy maps the circuit status names to the values used before
+// netbox 2.7.
+var circuitStatusLegacy = map[string]int{
+	"deprovisioning": 0,
+	"active":         1,
+	"planned":        2,
+	"provisioning":   3,
+	"offline":        4,
+	"decommissioned": 5,
+}
+
+func dataSourceNetboxCircuit() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxCircuitRead,
+		Schema: dataSourceCircuitSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxCircuitRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("cid"); ok {
+		query.Set("cid", v.(string))
+	}
+	if v, ok := d.GetOk("provider_id"); ok {
+		query.Set("provider_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of cid or provider_id")
+	}
+	circuit, err := netboxObjectLookup(meta, "circuits/circuits/", query, "Circuit")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(circuit["id"]))
+	setCircuitFields(d, meta, circuit)
+	return nil
+}
+
+// setCircuitFields sets the attributes of a netbox_circuit from an API answer.
+func setCircuitFields(d *schema.ResourceData, meta interface{}, circuit map[string]interface{}) {
+	d.Set("cid", stringValue(circuit["cid"]))
+	d.Set("provider_id", nestedID(circuit["provider"]))
+	d.Set("type_id", nestedID(circuit["type"]))
+	d.Set("status", flattenChoice(circuit["status"], circuitStatusLegacy))
+	d.Set("tenant_id", nestedID(circuit["tenant"]))
+	d.Set("install_date", stringValue(circuit["install_date"]))
+	d.Set("commit_rate", intValue(circuit["commit_rate"]))
+	d.Set("description", stringValue(circuit["description"]))
+	d.Set("comments", stringValue(circuit["comments"]))
+	d.Set("tags", flattenTags(circuit["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, circuit["custom_fields"]))
+}
+
+func bareCircuitSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Circuit ID given by the provider.
+		"cid": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"provider_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"type_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Date of installation, as YYYY-MM-DD.
+		"install_date": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Committed rate in Kbps.
+		"commit_rate": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceCircuitSchema() map[string]*schema.Schema {
+	s := bareCircuitSchema()
+	for k, v := range s {
+		switch k {
+		case "cid", "provider_id", "type_id":
+			v.Required = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "tenant_id", "commit_rate", "description", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "install_date":
+			v.Optional = true
+			v.ValidateFunc = validateDate
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceCircuitSchema returns the schema for the netbox_circuit data
+// source, searched by circuit ID, and optionally by provider.
+func dataSourceCircuitSchema() map[string]*schema.Schema {
+	s := bareCircuitSchema()
+	for k, v := range s {
+		switch k {
+		case "cid", "provider_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
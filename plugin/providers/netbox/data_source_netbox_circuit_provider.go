@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxCircuitProvider() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxCircuitProviderRead,
+		Schema: dataSourceCircuitProviderSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxCircuitProviderRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	provider, err := netboxObjectLookup(meta, "circuits/providers/", query, "Circuit provider")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(provider["id"]))
+	setCircuitProviderFields(d, meta, provider)
+	return nil
+}
+
+// setCircuitProviderFields sets the attributes of a netbox_circuit_provider
+// from an API answer.
+func setCircuitProviderFields(d *schema.ResourceData, meta interface{}, provider map[string]interface{}) {
+	d.Set("name", stringValue(provider["name"]))
+	d.Set("slug", stringValue(provider["slug"]))
+	d.Set("description", stringValue(provider["description"]))
+	d.Set("comments", stringValue(provider["comments"]))
+	d.Set("tags", flattenTags(provider["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, provider["custom_fields"]))
+}
+
+func bareCircuitProviderSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Netbox 3.2+.
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceCircuitProviderSchema() map[string]*schema.Schema {
+	s := bareCircuitProviderSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "description", "comments", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceCircuitProviderSchema returns the schema for the
+// netbox_circuit_provider data source, searched by name or slug.
+func dataSourceCircuitProviderSchema() map[string]*schema.Schema {
+	s := bareCircuitProviderSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,162 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceNetboxCircuitTermination() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxCircuitTerminationRead,
+		Schema: dataSourceCircuitTerminationSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxCircuitTerminationRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("circuit_id"); ok {
+		query.Set("circuit_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("term_side"); ok {
+		query.Set("term_side", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of circuit_id or term_side")
+	}
+	termination, err := netboxObjectLookup(meta, "circuits/circuit-terminations/", query, "Circuit termination")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(termination["id"]))
+	setCircuitTerminationFields(d, meta, termination)
+	return nil
+}
+
+// setCircuitTerminationFields sets the attributes of a
+// netbox_circuit_termination from an API answer.
+func setCircuitTerminationFields(d *schema.ResourceData, meta interface{}, termination map[string]interface{}) {
+	d.Set("circuit_id", nestedID(termination["circuit"]))
+	d.Set("term_side", stringValue(termination["term_side"]))
+	site, network := circuitTerminationPoint(termination)
+	d.Set("site_id", site)
+	d.Set("provider_network_id", network)
+	d.Set("port_speed", intValue(termination["port_speed"]))
+	d.Set("upstream_speed", intValue(termination["upstream_speed"]))
+	d.Set("xconnect_id", stringValue(termination["xconnect_id"]))
+	d.Set("pp_info", stringValue(termination["pp_info"]))
+	d.Set("description", stringValue(termination["description"]))
+	d.Set("mark_connected", boolValue(termination["mark_connected"]))
+	d.Set("tags", flattenTags(termination["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, termination["custom_fields"]))
+	d.Set("cable_id", nestedID(termination["cable"]))
+}
+
+func bareCircuitTerminationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"circuit_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"term_side": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Provider network of the termination, netbox 2.10+.
+		"provider_network_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Physical speed in Kbps.
+		"port_speed": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Upstream speed in Kbps, when different from port_speed.
+		"upstream_speed": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// ID of the local cross-connect.
+		"xconnect_id": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Patch panel and port of the termination.
+		"pp_info": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the termination is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+}
+
+func resourceCircuitTerminationSchema() map[string]*schema.Schema {
+	s := bareCircuitTerminationSchema()
+	for k, v := range s {
+		switch k {
+		case "circuit_id":
+			v.Required = true
+			v.ForceNew = true
+		case "term_side":
+			v.Required = true
+			v.ForceNew = true
+			v.ValidateFunc = validation.StringInSlice([]string{"A", "Z"}, false)
+		case "site_id":
+			v.Optional = true
+			v.ConflictsWith = []string{"provider_network_id"}
+		case "provider_network_id":
+			v.Optional = true
+			v.ConflictsWith = []string{"site_id"}
+		case "port_speed", "upstream_speed", "xconnect_id", "pp_info", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceCircuitTerminationSchema returns the schema for the
+// netbox_circuit_termination data source, searched by circuit, and optionally
+// by side.
+func dataSourceCircuitTerminationSchema() map[string]*schema.Schema {
+	s := bareCircuitTerminationSchema()
+	for k, v := range s {
+		switch k {
+		case "circuit_id", "term_side":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// circuitTerminationPoint returns the site and the provider network of a
+// circuit termination of an API answer. Netbox 4.2+ sends them as a generic
+// termination.
+func circuitTerminationPoint(termination map[string]interface{}) (int, int) {
+	switch stringValue(termination["termination_type"]) {
+	case "dcim.site":
+		return nestedID(termination["termination"]), 0
+	case "circuits.providernetwork":
+		return 0, nestedID(termination["termination"])
+	}
+	return nestedID(termination["site"]), nestedID(termination["provider_network"])
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxCircuitTypeRead,
+		Schema: dataSourceCircuitTypeSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxCircuitTypeRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	circuitType, err := netboxObjectLookup(meta, "circuits/circuit-types/", query, "Circuit type")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(circuitType["id"]))
+	setCircuitTypeFields(d, meta, circuitType)
+	return nil
+}
+
+// setCircuitTypeFields sets the attributes of a netbox_circuit_type from an
+// API answer.
+func setCircuitTypeFields(d *schema.ResourceData, meta interface{}, circuitType map[string]interface{}) {
+	d.Set("name", stringValue(circuitType["name"]))
+	d.Set("slug", stringValue(circuitType["slug"]))
+	d.Set("description", stringValue(circuitType["description"]))
+	d.Set("tags", flattenTags(circuitType["tags"]))
+}
+
+func bareCircuitTypeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceCircuitTypeSchema() map[string]*schema.Schema {
+	s := bareCircuitTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceCircuitTypeSchema returns the schema for the netbox_circuit_type
+// data source, searched by name or slug.
+func dataSourceCircuitTypeSchema() map[string]*schema.Schema {
+	s := bareCircuitTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
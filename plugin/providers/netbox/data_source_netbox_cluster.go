@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxCluster() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxClusterRead,
+		Schema: dataSourceClusterSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("type_id"); ok {
+		query.Set("type_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("group_id"); ok {
+		query.Set("group_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or type_id or group_id")
+	}
+	cluster, err := netboxObjectLookup(meta, "virtualization/clusters/", query, "Cluster")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(cluster["id"]))
+	setClusterFields(d, meta, cluster)
+	return nil
+}
+
+// setClusterFields sets the attributes of a netbox_cluster from an API answer.
+func setClusterFields(d *schema.ResourceData, meta interface{}, cluster map[string]interface{}) {
+	d.Set("name", stringValue(cluster["name"]))
+	d.Set("type_id", nestedID(cluster["type"]))
+	d.Set("group_id", nestedID(cluster["group"]))
+	d.Set("site_id", clusterSiteID(cluster))
+	d.Set("status", choiceValue(cluster["status"]))
+	d.Set("tenant_id", nestedID(cluster["tenant"]))
+	d.Set("comments", stringValue(cluster["comments"]))
+	d.Set("tags", flattenTags(cluster["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, cluster["custom_fields"]))
+	d.Set("created", stringValue(cluster["created"]))
+	d.Set("last_updated", stringValue(cluster["last_updated"]))
+}
+
+func bareClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"type_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"group_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Site of the cluster, its scope on netbox 4.2+.
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Status of the cluster, netbox 3.3+.
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceClusterSchema() map[string]*schema.Schema {
+	s := bareClusterSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "type_id":
+			v.Required = true
+		case "group_id", "site_id", "tenant_id", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceClusterSchema returns the schema for the netbox_cluster data
+// source, searched by name, type or group.
+func dataSourceClusterSchema() map[string]*schema.Schema {
+	s := bareClusterSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "type_id", "group_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// clusterSiteID returns the site of a cluster of an API answer, that is its
+// scope on netbox 4.2+.
+func clusterSiteID(cluster map[string]interface{}) int {
+	if scopeType, ok := cluster["scope_type"]; ok {
+		if scopeType != "dcim.site" {
+			return 0
+		}
+		return nestedID(cluster["scope_id"])
+	}
+	return nestedID(cluster["site"])
+}
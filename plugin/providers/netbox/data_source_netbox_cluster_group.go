@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxClusterGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxClusterGroupRead,
+		Schema: dataSourceClusterGroupSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxClusterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	group, err := netboxObjectLookup(meta, "virtualization/cluster-groups/", query, "Cluster group")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(group["id"]))
+	setClusterGroupFields(d, meta, group)
+	return nil
+}
+
+// setClusterGroupFields sets the attributes of a netbox_cluster_group from an
+// API answer.
+func setClusterGroupFields(d *schema.ResourceData, meta interface{}, group map[string]interface{}) {
+	d.Set("name", stringValue(group["name"]))
+	d.Set("slug", stringValue(group["slug"]))
+	d.Set("description", stringValue(group["description"]))
+	d.Set("tags", flattenTags(group["tags"]))
+}
+
+func bareClusterGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceClusterGroupSchema() map[string]*schema.Schema {
+	s := bareClusterGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceClusterGroupSchema returns the schema for the netbox_cluster_group
+// data source, searched by name or slug.
+func dataSourceClusterGroupSchema() map[string]*schema.Schema {
+	s := bareClusterGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxClusterType() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxClusterTypeRead,
+		Schema: dataSourceClusterTypeSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	clusterType, err := netboxObjectLookup(meta, "virtualization/cluster-types/", query, "Cluster type")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(clusterType["id"]))
+	setClusterTypeFields(d, meta, clusterType)
+	return nil
+}
+
+// setClusterTypeFields sets the attributes of a netbox_cluster_type from an
+// API answer.
+func setClusterTypeFields(d *schema.ResourceData, meta interface{}, clusterType map[string]interface{}) {
+	d.Set("name", stringValue(clusterType["name"]))
+	d.Set("slug", stringValue(clusterType["slug"]))
+	d.Set("description", stringValue(clusterType["description"]))
+	d.Set("tags", flattenTags(clusterType["tags"]))
+}
+
+func bareClusterTypeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceClusterTypeSchema() map[string]*schema.Schema {
+	s := bareClusterTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceClusterTypeSchema returns the schema for the netbox_cluster_type
+// data source, searched by name or slug.
+func dataSourceClusterTypeSchema() map[string]*schema.Schema {
+	s := bareClusterTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
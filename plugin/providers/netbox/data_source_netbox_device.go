@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// deviceStatusLegacy maps the device status names to the values used before
+// netbox 2.7.
+var deviceStatusLegacy = map[string]int{
+	"offline":         0,
+	"active":          1,
+	"planned":         2,
+	"staged":          3,
+	"failed":          4,
+	"inventory":       5,
+	"decommissioning": 6,
+}
+
+// deviceFaceLegacy maps the rack faces to the values used before netbox 2.7.
+var deviceFaceLegacy = map[string]int{
+	"front": 0,
+	"rear":  1,
+}
+
+func dataSourceNetboxDevice() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxDeviceRead,
+		Schema: dataSourceDeviceSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("site_id"); ok {
+		query.Set("site_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("serial"); ok {
+		query.Set("serial", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or site_id or serial")
+	}
+	device, err := netboxObjectLookup(meta, "dcim/devices/", query, "Device")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(device["id"]))
+	setDeviceFields(d, meta, device)
+	return nil
+}
+
+// setDeviceFields sets the attributes of a netbox_device from an API answer.
+func setDeviceFields(d *schema.ResourceData, meta interface{}, device map[string]interface{}) {
+	d.Set("name", stringValue(device["name"]))
+	d.Set("device_type_id", nestedID(device["device_type"]))
+	d.Set("role_id", deviceRoleID(device))
+	d.Set("site_id", nestedID(device["site"]))
+	d.Set("rack_id", nestedID(device["rack"]))
+	d.Set("position", floatValue(device["position"]))
+	d.Set("face", flattenChoice(device["face"], deviceFaceLegacy))
+	d.Set("status", flattenChoice(device["status"], deviceStatusLegacy))
+	d.Set("platform_id", nestedID(device["platform"]))
+	d.Set("tenant_id", nestedID(device["tenant"]))
+	d.Set("serial", stringValue(device["serial"]))
+	d.Set("asset_tag", stringValue(device["asset_tag"]))
+	d.Set("primary_ip4_id", nestedID(device["primary_ip4"]))
+	d.Set("primary_ip6_id", nestedID(device["primary_ip6"]))
+	d.Set("comments", stringValue(device["comments"]))
+	d.Set("tags", flattenTags(device["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, device["custom_fields"]))
+	d.Set("created", stringValue(device["created"]))
+	d.Set("last_updated", stringValue(device["last_updated"]))
+}
+
+func bareDeviceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Name of the device, unique in its site and tenant.
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"device_type_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Device role. Sent as device_role before netbox 3.6.
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"rack_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Lowest rack unit of the device, with rack_id and face.
+		"position": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		"face": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"platform_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"serial": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Unique asset tag.
+		"asset_tag": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Primary IPv4 address, that must be assigned to an interface of the
+		// device. Can also be managed by netbox_primary_ip.
+		"primary_ip4_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"primary_ip6_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceDeviceSchema() map[string]*schema.Schema {
+	s := bareDeviceSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "rack_id", "position", "platform_id", "tenant_id", "serial", "asset_tag", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "device_type_id", "role_id", "site_id":
+			v.Required = true
+		case "face":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"front", "rear"}, false)
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "primary_ip4_id", "primary_ip6_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceDeviceSchema returns the schema for the netbox_device data source,
+// searched by name, site or serial.
+func dataSourceDeviceSchema() map[string]*schema.Schema {
+	s := bareDeviceSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "site_id", "serial":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// deviceRoleID returns the role of a device of an API answer, that netbox
+// calls device_role before 3.6.
+func deviceRoleID(device map[string]interface{}) int {
+	if role, ok := device["role"]; ok {
+		return nestedID(role)
+	}
+	return nestedID(device["device_role"])
+}
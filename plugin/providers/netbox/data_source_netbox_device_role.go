@@ -0,0 +1,105 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxDeviceRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxDeviceRoleRead,
+		Schema: dataSourceDeviceRoleSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	role, err := netboxObjectLookup(meta, "dcim/device-roles/", query, "Device role")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(role["id"]))
+	setDeviceRoleFields(d, meta, role)
+	return nil
+}
+
+// setDeviceRoleFields sets the attributes of a netbox_device_role from an API
+// answer.
+func setDeviceRoleFields(d *schema.ResourceData, meta interface{}, role map[string]interface{}) {
+	d.Set("name", stringValue(role["name"]))
+	d.Set("slug", stringValue(role["slug"]))
+	d.Set("color", stringValue(role["color"]))
+	d.Set("vm_role", boolValue(role["vm_role"]))
+	d.Set("description", stringValue(role["description"]))
+	d.Set("tags", flattenTags(role["tags"]))
+}
+
+func bareDeviceRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether virtual machines may have the role.
+		"vm_role": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceDeviceRoleSchema() map[string]*schema.Schema {
+	s := bareDeviceRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "color":
+			v.Required = true
+			v.ValidateFunc = validateColor
+		case "vm_role":
+			v.Optional = true
+			v.Default = true
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceDeviceRoleSchema returns the schema for the netbox_device_role
+// data source, searched by name or slug.
+func dataSourceDeviceRoleSchema() map[string]*schema.Schema {
+	s := bareDeviceRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
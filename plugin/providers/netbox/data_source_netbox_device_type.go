@@ -0,0 +1,138 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceNetboxDeviceType() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxDeviceTypeRead,
+		Schema: dataSourceDeviceTypeSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("manufacturer_id"); ok {
+		query.Set("manufacturer_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("model"); ok {
+		query.Set("model", v.(string))
+	}
+	if v, ok := d.GetOk("slug"); ok {
+		query.Set("slug", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of manufacturer_id or model or slug")
+	}
+	deviceType, err := netboxObjectLookup(meta, "dcim/device-types/", query, "Device type")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(deviceType["id"]))
+	setDeviceTypeFields(d, meta, deviceType)
+	return nil
+}
+
+// setDeviceTypeFields sets the attributes of a netbox_device_type from an API
+// answer.
+func setDeviceTypeFields(d *schema.ResourceData, meta interface{}, deviceType map[string]interface{}) {
+	d.Set("manufacturer_id", nestedID(deviceType["manufacturer"]))
+	d.Set("model", stringValue(deviceType["model"]))
+	d.Set("slug", stringValue(deviceType["slug"]))
+	d.Set("part_number", stringValue(deviceType["part_number"]))
+	d.Set("u_height", floatValue(deviceType["u_height"]))
+	d.Set("is_full_depth", boolValue(deviceType["is_full_depth"]))
+	d.Set("subdevice_role", choiceValue(deviceType["subdevice_role"]))
+	d.Set("comments", stringValue(deviceType["comments"]))
+	d.Set("tags", flattenTags(deviceType["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, deviceType["custom_fields"]))
+	d.Set("created", stringValue(deviceType["created"]))
+	d.Set("last_updated", stringValue(deviceType["last_updated"]))
+}
+
+func bareDeviceTypeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"manufacturer_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"model": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"part_number": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Height in rack units. Netbox 3.0+ accepts half units, like 1.5.
+		"u_height": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		"is_full_depth": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// "parent" or "child" for the chassis and the blades, empty otherwise.
+		"subdevice_role": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceDeviceTypeSchema() map[string]*schema.Schema {
+	s := bareDeviceTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "manufacturer_id", "model", "slug":
+			v.Required = true
+		case "part_number", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "u_height":
+			v.Optional = true
+			v.Default = 1.0
+			v.ValidateFunc = validation.FloatBetween(0, 100)
+		case "is_full_depth":
+			v.Optional = true
+			v.Default = true
+		case "subdevice_role":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"", "parent", "child"}, false)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceDeviceTypeSchema returns the schema for the netbox_device_type
+// data source, searched by model or slug, and optionally by manufacturer.
+func dataSourceDeviceTypeSchema() map[string]*schema.Schema {
+	s := bareDeviceTypeSchema()
+	for k, v := range s {
+		switch k {
+		case "manufacturer_id", "model", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
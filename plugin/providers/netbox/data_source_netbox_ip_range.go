@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxIPRange() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxIPRangeRead,
+		Schema: dataSourceIPRangeSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxIPRangeRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("start_address"); ok {
+		query.Set("start_address", v.(string))
+	}
+	if v, ok := d.GetOk("end_address"); ok {
+		query.Set("end_address", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of start_address or end_address")
+	}
+	if v, ok := d.GetOk("vrf_id"); ok {
+		query.Set("vrf_id", strconv.Itoa(v.(int)))
+	}
+	ipRange, err := netboxObjectLookup(meta, "ipam/ip-ranges/", query, "IP range")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(ipRange["id"]))
+	setIPRangeFields(d, meta, ipRange)
+	return nil
+}
+
+// setIPRangeFields sets the attributes of a netbox_ip_range from an API
+// answer.
+func setIPRangeFields(d *schema.ResourceData, meta interface{}, ipRange map[string]interface{}) {
+	d.Set("start_address", stringValue(ipRange["start_address"]))
+	d.Set("end_address", stringValue(ipRange["end_address"]))
+	d.Set("size", intValue(ipRange["size"]))
+	d.Set("vrf_id", nestedID(ipRange["vrf"]))
+	d.Set("tenant_id", nestedID(ipRange["tenant"]))
+	d.Set("status", choiceValue(ipRange["status"]))
+	d.Set("role_id", nestedID(ipRange["role"]))
+	d.Set("description", stringValue(ipRange["description"]))
+	d.Set("tags", flattenTags(ipRange["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, ipRange["custom_fields"]))
+	d.Set("created", stringValue(ipRange["created"]))
+	d.Set("last_updated", stringValue(ipRange["last_updated"]))
+}
+
+// ipFamily returns the family (4 or 6) of an address like 10.0.0.1/24.
+func ipFamily(address string) (int, error) {
+	ip, _, err := net.ParseCIDR(address)
+	if err != nil {
+		return 0, err
+	}
+	if ip.To4() != nil {
+		return 4, nil
+	}
+	return 6, nil
+}
+
+// validateIPAddressWithMask checks that a value is an address with its mask,
+// like 10.0.0.1/24.
+func validateIPAddressWithMask(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := ipFamily(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IP address with mask, like 10.0.0.1/24: %v", k, err))
+	}
+	return
+}
+
+func bareIPRangeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"end_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"size": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"vrf_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceIPRangeSchema() map[string]*schema.Schema {
+	s := bareIPRangeSchema()
+	for k, v := range s {
+		switch k {
+		case "start_address", "end_address":
+			v.Required = true
+			v.ValidateFunc = validateIPAddressWithMask
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "vrf_id", "tenant_id", "role_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceIPRangeSchema returns the schema for the netbox_ip_range data
+// source, searched by its start and/or end address, optionally in a VRF.
+func dataSourceIPRangeSchema() map[string]*schema.Schema {
+	s := bareIPRangeSchema()
+	for k, v := range s {
+		switch k {
+		case "start_address", "end_address", "vrf_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,20 @@
+package netbox
+
+import (
+	"testing"
+)
+
+func TestIPFamily(t *testing.T) {
+	cases := map[string]int{
+		"10.0.0.10/24":    4,
+		"2001:db8::10/64": 6,
+	}
+	for address, want := range cases {
+		if got, err := ipFamily(address); err != nil || got != want {
+			t.Errorf("ipFamily(%q) = %d, %v, want %d", address, got, err, want)
+		}
+	}
+	if _, errs := validateIPAddressWithMask("10.0.0.10", "start_address"); len(errs) == 0 {
+		t.Error("validateIPAddressWithMask accepted an address without mask")
+	}
+}
@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxIpamRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxIpamRoleRead,
+		Schema: dataSourceIpamRoleSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxIpamRoleRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	role, err := netboxObjectLookup(meta, "ipam/roles/", query, "Role")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(role["id"]))
+	setIpamRoleFields(d, meta, role)
+	return nil
+}
+
+// setIpamRoleFields sets the attributes of a netbox_ipam_role from an API
+// answer.
+func setIpamRoleFields(d *schema.ResourceData, meta interface{}, role map[string]interface{}) {
+	d.Set("name", stringValue(role["name"]))
+	d.Set("slug", stringValue(role["slug"]))
+	d.Set("weight", intValue(role["weight"]))
+	d.Set("description", stringValue(role["description"]))
+}
+
+func bareIpamRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"weight": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceIpamRoleSchema() map[string]*schema.Schema {
+	s := bareIpamRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "weight":
+			v.Optional = true
+			v.Default = 1000
+		case "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceIpamRoleSchema returns the schema for the netbox_ipam_role data
+// source, searched by name or slug.
+func dataSourceIpamRoleSchema() map[string]*schema.Schema {
+	s := bareIpamRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
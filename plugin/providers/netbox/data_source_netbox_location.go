@@ -0,0 +1,115 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// locationPath returns the path of the locations, that netbox calls rack
+// groups before 2.11.
+func locationPath(meta interface{}) string {
+	if netboxVersionAtLeast(meta, "2.11") {
+		return "dcim/locations/"
+	}
+	return "dcim/rack-groups/"
+}
+
+func dataSourceNetboxLocation() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxLocationRead,
+		Schema: dataSourceLocationSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxLocationRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("slug"); ok {
+		query.Set("slug", v.(string))
+	}
+	if v, ok := d.GetOk("site_id"); ok {
+		query.Set("site_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or slug or site_id")
+	}
+	location, err := netboxObjectLookup(meta, locationPath(meta), query, "Location")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(location["id"]))
+	setLocationFields(d, meta, location)
+	return nil
+}
+
+// setLocationFields sets the attributes of a netbox_location from an API
+// answer.
+func setLocationFields(d *schema.ResourceData, meta interface{}, location map[string]interface{}) {
+	d.Set("name", stringValue(location["name"]))
+	d.Set("slug", stringValue(location["slug"]))
+	d.Set("site_id", nestedID(location["site"]))
+	d.Set("parent_id", nestedID(location["parent"]))
+	d.Set("description", stringValue(location["description"]))
+	d.Set("tags", flattenTags(location["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, location["custom_fields"]))
+}
+
+func bareLocationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Parent location, netbox 2.8+.
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceLocationSchema() map[string]*schema.Schema {
+	s := bareLocationSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug", "site_id":
+			v.Required = true
+		case "parent_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceLocationSchema returns the schema for the netbox_location data
+// source, searched by name or slug, and optionally by site.
+func dataSourceLocationSchema() map[string]*schema.Schema {
+	s := bareLocationSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug", "site_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxManufacturer() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxManufacturerRead,
+		Schema: dataSourceManufacturerSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxManufacturerRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	manufacturer, err := netboxObjectLookup(meta, "dcim/manufacturers/", query, "Manufacturer")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(manufacturer["id"]))
+	setManufacturerFields(d, meta, manufacturer)
+	return nil
+}
+
+// setManufacturerFields sets the attributes of a netbox_manufacturer from an
+// API answer.
+func setManufacturerFields(d *schema.ResourceData, meta interface{}, manufacturer map[string]interface{}) {
+	d.Set("name", stringValue(manufacturer["name"]))
+	d.Set("slug", stringValue(manufacturer["slug"]))
+	d.Set("description", stringValue(manufacturer["description"]))
+	d.Set("tags", flattenTags(manufacturer["tags"]))
+}
+
+func bareManufacturerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceManufacturerSchema() map[string]*schema.Schema {
+	s := bareManufacturerSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceManufacturerSchema returns the schema for the netbox_manufacturer
+// data source, searched by name or slug.
+func dataSourceManufacturerSchema() map[string]*schema.Schema {
+	s := bareManufacturerSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
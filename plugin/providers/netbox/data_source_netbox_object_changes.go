@@ -0,0 +1,181 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// objectChangeActionLegacy maps the change actions to the values used before
+// netbox 2.7.
+var objectChangeActionLegacy = map[string]int{
+	"create": 1,
+	"update": 2,
+	"delete": 3,
+}
+
+// dataSourceNetboxObjectChanges returns the change log entries of netbox,
+// newest first, like the changes of a prefix made outside terraform.
+func dataSourceNetboxObjectChanges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxObjectChangesRead,
+		Schema: map[string]*schema.Schema{
+			// Type of the changed objects, like "ipam.prefix".
+			"changed_object_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"changed_object_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"user_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"create", "update", "delete"}, false),
+			},
+			// Time range of the changes, as RFC 3339 timestamps like
+			// "2024-01-15T00:00:00Z".
+			"time_after": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTimestamp,
+			},
+			"time_before": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTimestamp,
+			},
+			// Maximum number of changes read, the newest ones, as the change
+			// log of netbox can be huge.
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, netboxAPIPageSize),
+			},
+			"changes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// Changes of the same request share it.
+						"request_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"changed_object_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"changed_object_id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"object_repr": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						// Data of the object before and after the change, as
+						// JSON documents. Netbox before 2.10 only keeps the
+						// data after it.
+						"prechange_data": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"postchange_data": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxObjectChangesRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	for _, key := range []string{"changed_object_type", "user_name", "time_after", "time_before"} {
+		if v, ok := d.GetOk(key); ok {
+			query.Set(key, v.(string))
+		}
+	}
+	if v, ok := d.GetOk("changed_object_id"); ok {
+		query.Set("changed_object_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("action"); ok {
+		query.Set("action", fmt.Sprintf("%v", expandChoice(meta, v.(string), objectChangeActionLegacy)))
+	}
+	path := "extras/object-changes/"
+	if netboxVersionAtLeast(meta, "4.1") {
+		path = "core/object-changes/"
+	}
+	query.Set("limit", strconv.Itoa(d.Get("limit").(int)))
+	var page struct {
+		Results []map[string]interface{} `json:"results"`
+	}
+	if err := netboxAPIRequest(meta, "GET", path+"?"+query.Encode(), nil, &page); err != nil {
+		return err
+	}
+	changes := make([]map[string]interface{}, 0, len(page.Results))
+	for _, change := range page.Results {
+		changes = append(changes, flattenObjectChange(change))
+	}
+	d.SetId(path + "?" + query.Encode())
+	d.Set("changes", changes)
+	return nil
+}
+
+// flattenObjectChange returns the attributes of a change of an API answer.
+func flattenObjectChange(change map[string]interface{}) map[string]interface{} {
+	post, ok := change["postchange_data"]
+	if !ok {
+		// Netbox before 2.10.
+		post = change["object_data"]
+	}
+	return map[string]interface{}{
+		"id":                  nestedID(change["id"]),
+		"time":                stringValue(change["time"]),
+		"user_name":           stringValue(change["user_name"]),
+		"request_id":          stringValue(change["request_id"]),
+		"action":              flattenChoice(change["action"], objectChangeActionLegacy),
+		"changed_object_type": stringValue(change["changed_object_type"]),
+		"changed_object_id":   nestedID(change["changed_object_id"]),
+		"object_repr":         stringValue(change["object_repr"]),
+		"prechange_data":      flattenJSON(change["prechange_data"]),
+		"postchange_data":     flattenJSON(post),
+	}
+}
+
+// validateTimestamp checks that a value is an RFC 3339 timestamp.
+func validateTimestamp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a timestamp like 2018-12-31T23:59:59Z: %v", k, err))
+	}
+	return
+}
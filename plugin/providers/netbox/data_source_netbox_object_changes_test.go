@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccDataSourceNetboxObjectChangesConfig = `
+resource "netbox_vrf" "test" {
+  name = "terraform-test-changes"
+}
+
+data "netbox_object_changes" "test" {
+  changed_object_type = "ipam.vrf"
+  changed_object_id   = "${netbox_vrf.test.id}"
+  action              = "create"
+}
+`
+
+func TestAccDataSourceNetboxObjectChanges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceNetboxObjectChangesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.action", "create"),
+					resource.TestCheckResourceAttr("data.netbox_object_changes.test", "changes.0.object_repr", "terraform-test-changes"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenObjectChange(t *testing.T) {
+	old := map[string]interface{}{
+		"action":      map[string]interface{}{"value": float64(2), "label": "Updated"},
+		"object_data": map[string]interface{}{"name": "vrf1"},
+	}
+	change := flattenObjectChange(old)
+	if change["action"] != "update" || change["postchange_data"] != `{"name":"vrf1"}` || change["prechange_data"] != "" {
+		t.Errorf("flattenObjectChange(%v) = %v", old, change)
+	}
+	current := map[string]interface{}{
+		"action":          map[string]interface{}{"value": "delete", "label": "Deleted"},
+		"prechange_data":  map[string]interface{}{"name": "vrf1"},
+		"postchange_data": nil,
+	}
+	change = flattenObjectChange(current)
+	if change["action"] != "delete" || change["prechange_data"] != `{"name":"vrf1"}` || change["postchange_data"] != "" {
+		t.Errorf("flattenObjectChange(%v) = %v", current, change)
+	}
+	if _, errs := validateTimestamp("2024-01-15", "time_after"); len(errs) == 0 {
+		t.Error("validateTimestamp accepted a date without time")
+	}
+}
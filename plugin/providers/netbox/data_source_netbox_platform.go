@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxPlatform() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxPlatformRead,
+		Schema: dataSourcePlatformSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	platform, err := netboxObjectLookup(meta, "dcim/platforms/", query, "Platform")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(platform["id"]))
+	setPlatformFields(d, meta, platform)
+	return nil
+}
+
+// setPlatformFields sets the attributes of a netbox_platform from an API
+// answer.
+func setPlatformFields(d *schema.ResourceData, meta interface{}, platform map[string]interface{}) {
+	d.Set("name", stringValue(platform["name"]))
+	d.Set("slug", stringValue(platform["slug"]))
+	d.Set("manufacturer_id", nestedID(platform["manufacturer"]))
+	d.Set("description", stringValue(platform["description"]))
+	d.Set("tags", flattenTags(platform["tags"]))
+}
+
+func barePlatformSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Optional manufacturer the platform is limited to.
+		"manufacturer_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourcePlatformSchema() map[string]*schema.Schema {
+	s := barePlatformSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "manufacturer_id", "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourcePlatformSchema returns the schema for the netbox_platform data
+// source, searched by name or slug.
+func dataSourcePlatformSchema() map[string]*schema.Schema {
+	s := barePlatformSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,216 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// powerFeedStatusLegacy, powerFeedTypeLegacy, powerFeedSupplyLegacy and
+// powerFeedPhaseLegacy map the power feed choices to the values used before
+// netbox 2.7.
+var powerFeedStatusLegacy = map[string]int{
+	"offline": 0,
+	"active":  1,
+	"planned": 2,
+	"failed":  4,
+}
+
+var powerFeedTypeLegacy = map[string]int{
+	"primary":   1,
+	"redundant": 2,
+}
+
+var powerFeedSupplyLegacy = map[string]int{
+	"ac": 1,
+	"dc": 2,
+}
+
+var powerFeedPhaseLegacy = map[string]int{
+	"single-phase": 1,
+	"three-phase":  3,
+}
+
+func dataSourceNetboxPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxPowerFeedRead,
+		Schema: dataSourcePowerFeedSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxPowerFeedRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("power_panel_id"); ok {
+		query.Set("power_panel_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("rack_id"); ok {
+		query.Set("rack_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or power_panel_id or rack_id")
+	}
+	feed, err := netboxObjectLookup(meta, "dcim/power-feeds/", query, "Power feed")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(feed["id"]))
+	setPowerFeedFields(d, meta, feed)
+	return nil
+}
+
+// setPowerFeedFields sets the attributes of a netbox_power_feed from an API
+// answer.
+func setPowerFeedFields(d *schema.ResourceData, meta interface{}, feed map[string]interface{}) {
+	d.Set("name", stringValue(feed["name"]))
+	d.Set("power_panel_id", nestedID(feed["power_panel"]))
+	d.Set("rack_id", nestedID(feed["rack"]))
+	d.Set("status", flattenChoice(feed["status"], powerFeedStatusLegacy))
+	d.Set("type", flattenChoice(feed["type"], powerFeedTypeLegacy))
+	d.Set("supply", flattenChoice(feed["supply"], powerFeedSupplyLegacy))
+	d.Set("phase", flattenChoice(feed["phase"], powerFeedPhaseLegacy))
+	d.Set("voltage", intValue(feed["voltage"]))
+	d.Set("amperage", intValue(feed["amperage"]))
+	d.Set("max_utilization", intValue(feed["max_utilization"]))
+	d.Set("tenant_id", nestedID(feed["tenant"]))
+	d.Set("mark_connected", boolValue(feed["mark_connected"]))
+	d.Set("description", stringValue(feed["description"]))
+	d.Set("comments", stringValue(feed["comments"]))
+	d.Set("tags", flattenTags(feed["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, feed["custom_fields"]))
+	setConnectionFields(d, feed)
+}
+
+func barePowerFeedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"power_panel_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"rack_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"supply": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"phase": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Voltage in volts, negative for some DC feeds.
+		"voltage": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"amperage": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Maximum utilization in percent.
+		"max_utilization": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Tenant of the feed, netbox 4.0+.
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Whether the feed is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// Netbox 3.3+.
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Power port at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourcePowerFeedSchema() map[string]*schema.Schema {
+	s := barePowerFeedSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "power_panel_id":
+			v.Required = true
+		case "rack_id", "tenant_id", "description", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "type":
+			v.Optional = true
+			v.Default = "primary"
+			v.ValidateFunc = validation.StringInSlice([]string{"primary", "redundant"}, false)
+		case "supply":
+			v.Optional = true
+			v.Default = "ac"
+			v.ValidateFunc = validation.StringInSlice([]string{"ac", "dc"}, false)
+		case "phase":
+			v.Optional = true
+			v.Default = "single-phase"
+			v.ValidateFunc = validation.StringInSlice([]string{"single-phase", "three-phase"}, false)
+		case "voltage":
+			v.Optional = true
+			v.Default = 120
+			v.ValidateFunc = validation.IntBetween(-32768, 32767)
+		case "amperage":
+			v.Optional = true
+			v.Default = 20
+			v.ValidateFunc = validation.IntAtLeast(1)
+		case "max_utilization":
+			v.Optional = true
+			v.Default = 80
+			v.ValidateFunc = validation.IntBetween(1, 100)
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourcePowerFeedSchema returns the schema for the netbox_power_feed data
+// source, searched by name, power panel or rack.
+func dataSourcePowerFeedSchema() map[string]*schema.Schema {
+	s := barePowerFeedSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "power_panel_id", "rack_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxPowerPanel() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxPowerPanelRead,
+		Schema: dataSourcePowerPanelSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxPowerPanelRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("site_id"); ok {
+		query.Set("site_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or site_id")
+	}
+	panel, err := netboxObjectLookup(meta, "dcim/power-panels/", query, "Power panel")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(panel["id"]))
+	setPowerPanelFields(d, meta, panel)
+	return nil
+}
+
+// setPowerPanelFields sets the attributes of a netbox_power_panel from an API
+// answer.
+func setPowerPanelFields(d *schema.ResourceData, meta interface{}, panel map[string]interface{}) {
+	d.Set("name", stringValue(panel["name"]))
+	d.Set("site_id", nestedID(panel["site"]))
+	d.Set("location_id", powerPanelLocationID(panel))
+	d.Set("tags", flattenTags(panel["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, panel["custom_fields"]))
+}
+
+func barePowerPanelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Location of the panel, sent as rack_group before netbox 2.11.
+		"location_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourcePowerPanelSchema() map[string]*schema.Schema {
+	s := barePowerPanelSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "site_id":
+			v.Required = true
+		case "location_id", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourcePowerPanelSchema returns the schema for the netbox_power_panel
+// data source, searched by name, and optionally by site.
+func dataSourcePowerPanelSchema() map[string]*schema.Schema {
+	s := barePowerPanelSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "site_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// powerPanelLocationID returns the location of a power panel of an API
+// answer, that netbox calls rack_group before 2.11.
+func powerPanelLocationID(panel map[string]interface{}) int {
+	if location, ok := panel["location"]; ok {
+		return nestedID(location)
+	}
+	return nestedID(panel["rack_group"])
+}
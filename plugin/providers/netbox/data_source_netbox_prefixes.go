@@ -4,13 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 
-	// "errors"
-
-	"github.com/digitalocean/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform/helper/schema"
-	// "github.com/digitalocean/go-netbox/netbox/client"
 )
 
 func dataSourceNetboxPrefixes() *schema.Resource {
@@ -20,72 +17,42 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 	}
 }
 
-// Read will fetch the data of a resource.
+// Read will fetch the data of a resource. The prefix is read with the raw
+// API, as the go-netbox models do not know the tags of the newer netbox
+// versions.
 func dataSourceNetboxPrefixesRead(d *schema.ResourceData, meta interface{}) error {
-	//out := ipam.NewIPAMPrefixesListParams()
 	log.Printf("data_source_netbox_prefixes.go dataSourceNetboxPrefixesRead ............ ")
+	var prefix map[string]interface{}
 	switch {
 	// Pega por prefix_id
 	case d.Get("prefixes_id").(int) != 0:
-		var parm = ipam.NewIPAMPrefixesReadParams()
-		parm.SetID(int64(d.Get("prefixes_id").(int)))
-		//(&&meta).IPAM.IPAMPrefixesRead(parm,nil)
-
-		c := meta.(*ProviderNetboxClient).client
-		log.Printf("Obtive o client\n")
-		//parms = ipam.NewIPAMPrefixesListParams()
-		out, err := c.IPAM.IPAMPrefixesRead(parm, nil)
-		log.Printf("- Executado...\n")
-		if err == nil {
-
-			d.SetId(strconv.FormatInt(out.Payload.ID, 10)) // Sempre setar o ID
-			d.Set("created", out.Payload.Created.String())
-			d.Set("description", out.Payload.Description)
-			d.Set("family", out.Payload.Family)
-			d.Set("is_pool", out.Payload.IsPool)
-			d.Set("prefix", out.Payload.Prefix)
-			d.Set("last_updated", out.Payload.LastUpdated)
-			d.Set("vlan_vid", *out.Payload.Vlan.Vid)
-			log.Print("\n")
-		} else {
-			log.Printf("erro na chamada do IPAMPrefixesList\n")
+		path := "ipam/prefixes/" + strconv.Itoa(d.Get("prefixes_id").(int)) + "/"
+		if err := netboxAPIRequest(meta, "GET", path, nil, &prefix); err != nil {
+			log.Printf("erro na chamada do GET prefixes\n")
 			log.Printf("Err: %v\n", err)
-			log.Print("\n")
 			return err
 		}
 		// Pega por prefix.vlan.vid
 	case d.Get("vlan_vid").(int) != 0:
-		var parml = ipam.NewIPAMPrefixesListParams()
-		vlan_vid := float64(d.Get("vlan_vid").(int))
-		parml.SetVlanVid(&vlan_vid)
-		c := meta.(*ProviderNetboxClient).client
-		out, err := c.IPAM.IPAMPrefixesList(parml, nil)
-		if err == nil {
-			if *out.Payload.Count == 0 {
-				return errors.New("Prefix not found")
-			} else if *out.Payload.Count > 1 {
-				return errors.New(fmt.Sprintf("More than one Prefix found with vid %v\n", d.Get("vlanvid").(int)))
-			}
-			result := out.Payload.Results[0]
-			d.SetId(strconv.FormatInt(result.ID, 10)) // Sempre setar o ID
-			d.Set("created", result.Created.String())
-			d.Set("custom_fields", result.CustomFields)
-			d.Set("description", result.Description)
-			d.Set("family", result.Family)
-			d.Set("is_pool", result.IsPool)
-			d.Set("prefix", result.Prefix)
-			d.Set("last_updated", result.LastUpdated)
-			d.Set("vlan_Vid", *result.Vlan.Vid)
-			log.Print("\n")
-		} else {
-			log.Printf("erro na chamada do IPAMPrefixesList\n")
+		query := url.Values{}
+		query.Set("vlan_vid", strconv.Itoa(d.Get("vlan_vid").(int)))
+		results, err := netboxAPIList(meta, "ipam/prefixes/", query)
+		if err != nil {
+			log.Printf("erro na chamada do GET prefixes\n")
 			log.Printf("Err: %v\n", err)
-			log.Print("\n")
 			return err
 		}
+		if len(results) == 0 {
+			return errors.New("Prefix not found")
+		} else if len(results) > 1 {
+			return fmt.Errorf("More than one Prefix found with vid %v\n", d.Get("vlan_vid").(int))
+		}
+		prefix = results[0]
 	default:
 		return errors.New("No valid combination of parameters found - prefix_id or vlan_vid")
 	}
+	d.SetId(idFromAPI(prefix["id"])) // Sempre setar o ID
+	setPrefixesFields(d, meta, prefix)
 	return nil
 }
 
@@ -115,9 +82,24 @@ func barePrefixesSchema() map[string]*schema.Schema {
 		"last_updated": &schema.Schema{
 			Type: schema.TypeString,
 		},
+		// VID of the VLAN vlan_id, 0 when the prefix has no VLAN.
 		"vlan_vid": &schema.Schema{
 			Type: schema.TypeInt,
 		},
+		"vlan_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"vrf_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
 	}
 }
 
@@ -128,14 +110,15 @@ func resourcePrefixesSchema() map[string]*schema.Schema {
 		switch k {
 		case "prefixes_id":
 			v.Optional = true
-			v.ConflictsWith = []string{"vlan_vid"}
+			v.Computed = true
 		case "prefix":
 			v.Optional = true
 		case "created":
 			v.Optional = true
-		case "vlan_vid":
+		case "description":
+			v.Optional = true
+		case "vlan_id", "vrf_id", "role_id", "tenant_id", "tags":
 			v.Optional = true
-			v.ConflictsWith = []string{"prefixes_id"}
 		default:
 			v.Computed = true
 		}
@@ -1,17 +1,15 @@
 package netbox
 
 import (
-	"bytes"
-	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"fmt"
 	"log"
-	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/digitalocean/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	// "github.com/digitalocean/go-netbox/netbox/client/ipam"
 	// "github.com/digitalocean/go-netbox/netbox/client"
 )
@@ -23,6 +21,8 @@ func resourceNetboxPrefixesAvailableIps() *schema.Resource {
 		Update: resourceNetboxPrefixesAvailableIpsUpdate,
 		Delete: resourceNetboxPrefixesAvailableIpsDelete,
 		Schema: resourcePrefixesAvailableIpsSchema(),
+
+		CustomizeDiff: resourceNetboxPrefixesAvailableIpsCustomizeDiff,
 	}
 }
 
@@ -49,9 +49,7 @@ func barePrefixesAvailableIpsSchema() map[string]*schema.Schema {
 		"mask": &schema.Schema{
 			Type: schema.TypeString,
 		},
-		"custom_fields": &schema.Schema{
-			Type: schema.TypeMap,
-		},
+		"custom_fields": customFieldsSchema(),
 		"status": &schema.Schema{
 			Type: schema.TypeString,
 		},
@@ -79,6 +77,22 @@ func barePrefixesAvailableIpsSchema() map[string]*schema.Schema {
 		"status_label": &schema.Schema{
 			Type: schema.TypeString,
 		},
+		"vrf_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tags": tagsSchema(),
+		// Object the address is assigned to, like "dcim.interface" or
+		// "virtualization.vminterface", netbox 2.9+. Before it the address
+		// is assigned to the interface assigned_object_id.
+		"assigned_object_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"assigned_object_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
 	}
 }
 
@@ -92,7 +106,7 @@ func resourcePrefixesAvailableIpsSchema() map[string]*schema.Schema {
 		case "prefixes_id":
 			v.Optional = true
 			v.Computed = true
-		case "description":
+		case "description", "tags":
 			v.Optional = true
 		case "family":
 			v.Optional = true
@@ -114,6 +128,14 @@ func resourcePrefixesAvailableIpsSchema() map[string]*schema.Schema {
 			v.Computed = true
 		case "created":
 			v.Optional = true
+		case "vrf_id", "tenant_id":
+			v.Optional = true
+			v.Computed = true
+		case "assigned_object_type":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice(ipAssignedObjectTypes, false)
+		case "assigned_object_id":
+			v.Optional = true
 
 		default:
 			v.Computed = true
@@ -126,6 +148,43 @@ func resourcePrefixesAvailableIpsSchema() map[string]*schema.Schema {
 	return s
 }
 
+// ipAssignedObjectTypes are the types of the objects an address can be
+// assigned to.
+var ipAssignedObjectTypes = []string{"dcim.interface", "virtualization.vminterface", "ipam.fhrpgroup"}
+
+// resourceNetboxPrefixesAvailableIpsCustomizeDiff checks at plan time the
+// custom fields and that an assigned object has both a type and an id.
+func resourceNetboxPrefixesAvailableIpsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomFields(d, meta); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("assigned_object_type") || !d.NewValueKnown("assigned_object_id") {
+		return nil
+	}
+	_, hasType := d.GetOk("assigned_object_type")
+	_, hasID := d.GetOk("assigned_object_id")
+	if hasType != hasID {
+		return errors.New("assigned_object_type and assigned_object_id must be set together")
+	}
+	// Before netbox 2.9 addresses can only be assigned to device interfaces.
+	if t := d.Get("assigned_object_type").(string); hasType && t != "dcim.interface" && !netboxVersionAtLeast(meta, "2.9") {
+		return fmt.Errorf("assigned_object_type %s needs netbox 2.9+, only dcim.interface is supported before", t)
+	}
+	return nil
+}
+
+// expandIPAssignedObject adds the object the address is assigned to, or null
+// to unassign it, to data. Before netbox 2.9 addresses are assigned to an
+// interface.
+func expandIPAssignedObject(d *schema.ResourceData, meta interface{}, data map[string]interface{}) {
+	if !netboxVersionAtLeast(meta, "2.9") {
+		data["interface"] = optionalID(d, "assigned_object_id")
+		return
+	}
+	data["assigned_object_type"] = optionalString(d, "assigned_object_type")
+	data["assigned_object_id"] = optionalID(d, "assigned_object_id")
+}
+
 func resourceNetboxPrefixesAvailableIpsCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] JP dataNetboxPrefixesAvailableIpsCreate  ** : %v\n", d)
@@ -147,124 +206,52 @@ func resourceNetboxPrefixesAvailableIpsCreate(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Inclusao prefixo     %v\n", prefixes_id)
 	log.Printf("[DEBUG] Inclusao description %v\n", description)
 
-	c := meta.(*ProviderNetboxClient).configuration
-	log.Printf("[DEBUG] Configuration [%v]\n", c)
-	log.Printf("[DEBUG] AppID [%v]\n", c.AppID)
-	log.Printf("[DEBUG] Endpoint [%v]\n", c.Endpoint)
-
-	// log.Printf("%v", *Config.cfg)
-	// log.Printf("[DEBUG] Config.AppID %v\n", Config.AppID)
-	// log.Printf("[DEBUG] Config.Endpoint %v\n", *Config.Endpoint)
-
-	url := "http://" + c.Endpoint + "/api/ipam/prefixes/" + strconv.Itoa(prefixes_id) + "/available-ips/"
-	jsonData := map[string]string{"description": description}
-	jsonValue, _ := json.Marshal(jsonData)
-	log.Printf("[DEBUG] JSON: [%v]\n", string(jsonValue))
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonValue))
-	if err != nil {
-		log.Printf("[ERROR] Error occurred in POST to url [%v]\n", url)
-		log.Printf("[ERROR] Erro : %v\n", err)
-		return err
-	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("authorization", "Token "+c.AppID)
-	req.Header.Set("cache-control", "no-cache")
-	req.Header.Set("content-type", "application/json")
-	log.Println("[DEBUG] http.Client create")
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Println("[DEBUG] Error occurred after post")
-		log.Printf("[ERROR] Erro retorno http. %v \n", err)
-		return err
+	jsonData := map[string]interface{}{"description": description}
+	if v, ok := d.GetOk("vrf_id"); ok {
+		jsonData["vrf"] = v.(int)
 	}
-	log.Printf("[DEBUG] Http Code Response: %v\n", resp.StatusCode)
-	body, _ := ioutil.ReadAll(resp.Body)
-	log.Println("[DEBUG] Body Read")
-	defer resp.Body.Close()
-	if resp.StatusCode != 201 {
-		return errors.New("Return http code: " + strconv.Itoa(resp.StatusCode))
+	if v, ok := d.GetOk("tenant_id"); ok {
+		jsonData["tenant"] = v.(int)
 	}
+	jsonData["tags"] = expandTags(d, meta)
+	jsonData["custom_fields"] = expandCustomFields(d, meta)
+	expandIPAssignedObject(d, meta, jsonData)
 	// Dinamico ...
 	var i map[string]interface{}
-	log.Print("[DEBUG] Will Unmarshal the body")
-	jsonErr := json.Unmarshal(body, &i)
-	if jsonErr != nil {
-		log.Fatal(jsonErr)
+	path := "ipam/prefixes/" + strconv.Itoa(prefixes_id) + "/available-ips/"
+	if err := netboxAPIRequest(meta, "POST", path, jsonData, &i); err != nil {
+		log.Printf("[ERROR] Error occurred in POST to path [%v]\n", path)
+		log.Printf("[ERROR] Erro : %v\n", err)
+		return err
 	}
-	log.Println("[DEBUG] Unmarshal Ok")
-	log.Printf("[DEBUG] [%v]", string(body))
 	log.Println("[DEBUG] ID setting")
-	d.SetId(strconv.FormatFloat(i["id"].(float64), 'f', -1, 64))
-	log.Println("[DEBUG] family")
-	// log.Printf("[DEBUG] family [%v]\n", i["family"].(int))
-	// d.Set("family", i["family"].(int))
-	log.Println("[DEBUG] address")
-
-	d.Set("address", i["address"].(string))
-	d.Set("mask", strings.Split(i["address"].(string), "/")[1])
-	d.Set("ip", strings.Split(i["address"].(string), "/")[0])
-	d.Set("address_id", strconv.FormatFloat(i["id"].(float64), 'f', -1, 64))
-	log.Println("[DEBUG] description")
-	d.Set("description", i["description"].(string))
-	d.Set("status", i["status"])
-	d.Set("created", i["created"].(string))
-	d.Set("last_updated", i["last_updated"].(string))
+	d.SetId(idFromAPI(i["id"]))
+	netboxJournal(meta, "ipam/ip-addresses/", d.Id(), "Created")
+	setPrefixesAvailableIpsFields(d, meta, i)
 	log.Printf("Incluido id: %v\n", d.Id())
 	return nil
 }
 
 func resourceNetboxPrefixesAvailableIpsRead(d *schema.ResourceData, meta interface{}) error {
-	//out := ipam.NewIPAMPrefixesListParams()
 	log.Printf("resourceNetboxPrefixesAvailableIpsRead ............ ")
 	switch {
-	// Pega por prefix_id
+	// Pega por address_id
 	case d.Get("address_id").(string) != "":
-		var parm = ipam.NewIPAMIPAddressesReadParams()
-
-		id, _ := strconv.ParseInt(d.Get("address_id").(string), 10, 64)
-		parm.SetID(id)
-		//(&&meta).IPAM.IPAMPrefixesRead(parm,nil)
-
-		c := meta.(*ProviderNetboxClient).client
-		out, err := c.IPAM.IPAMIPAddressesRead(parm, nil)
-		log.Printf("- Executado...\n")
-		if err == nil {
-
-			d.Set("address_id", string(out.Payload.ID))
-			d.Set("address", out.Payload.Address)
-
-			d.Set("mask", strings.Split(*out.Payload.Address, "/")[1])
-			d.Set("ip", strings.Split(*out.Payload.Address, "/")[0])
-
-			log.Printf("Setando Address_id %v\n", out.Payload.ID)
-			d.Set("created", out.Payload.Created)
-			if out.Payload.CustomFields != nil {
-				d.Set("custom_fields", out.Payload.CustomFields)
-			}
-			d.Set("description", out.Payload.Description)
-			d.Set("family", out.Payload.Family)
-			if out.Payload.Interface != nil {
-				d.Set("interface_id", out.Payload.Interface.ID)
-				d.Set("interface_name", out.Payload.Interface.Name)
-			}
-			if out.Payload.Role != nil {
-				d.Set("role_id", out.Payload.Role.Value)
-				d.Set("role_label", out.Payload.Role.Label)
-			}
-			if out.Payload.Status != nil {
-				d.Set("status_id", out.Payload.Status.Value)
-				d.Set("status_label", out.Payload.Status.Value)
-			}
-
-			d.Set("last_updated", out.Payload.LastUpdated)
-			log.Print("\n")
-		} else {
-			log.Printf("erro na chamada do IPAMIPAddressesRead\n")
+		// The go-netbox models do not know the tags and choices of the newer
+		// netbox versions, so the address is read with the raw API.
+		var address map[string]interface{}
+		err := netboxAPIRequest(meta, "GET", "ipam/ip-addresses/"+d.Get("address_id").(string)+"/", nil, &address)
+		if isNotFound(err) {
+			log.Printf("Address_id %v not exist.\n", d.Get("address_id"))
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			log.Printf("erro na chamada do GET ip-addresses\n")
 			log.Printf("Err: %v\n", err)
-			log.Print("\n")
 			return err
 		}
+		setPrefixesAvailableIpsFields(d, meta, address)
 
 	default:
 		//return errors.New("No valid parameters found - address_id")
@@ -275,53 +262,73 @@ func resourceNetboxPrefixesAvailableIpsRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// setPrefixesAvailableIpsFields sets the attributes of a
+// netbox_prefixes_available_ips from an API answer.
+func setPrefixesAvailableIpsFields(d *schema.ResourceData, meta interface{}, address map[string]interface{}) {
+	d.Set("address_id", idFromAPI(address["id"]))
+	d.Set("address", stringValue(address["address"]))
+	if parts := strings.Split(stringValue(address["address"]), "/"); len(parts) == 2 {
+		d.Set("ip", parts[0])
+		d.Set("mask", parts[1])
+	}
+	d.Set("family", intValue(address["family"]))
+	if family, ok := address["family"].(map[string]interface{}); ok {
+		d.Set("family", intValue(family["value"]))
+	}
+	d.Set("description", stringValue(address["description"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, address["custom_fields"]))
+	d.Set("vrf_id", nestedID(address["vrf"]))
+	d.Set("tenant_id", nestedID(address["tenant"]))
+	d.Set("tags", flattenTags(address["tags"]))
+	// The interface of netbox before 2.9 is the assigned object after it.
+	assigned, ok := address["assigned_object"].(map[string]interface{})
+	if ok {
+		d.Set("assigned_object_type", stringValue(address["assigned_object_type"]))
+	} else if assigned, ok = address["interface"].(map[string]interface{}); ok {
+		d.Set("assigned_object_type", "dcim.interface")
+	} else {
+		d.Set("assigned_object_type", "")
+	}
+	d.Set("assigned_object_id", nestedID(assigned))
+	d.Set("interface_id", nestedID(assigned))
+	d.Set("interface_label", stringValue(assigned["name"]))
+	d.Set("status", choiceValue(address["status"]))
+	if status, ok := address["status"].(map[string]interface{}); ok {
+		d.Set("status_id", intValue(status["value"]))
+		d.Set("status_label", stringValue(status["label"]))
+	}
+	if role, ok := address["role"].(map[string]interface{}); ok {
+		d.Set("role_id", intValue(role["value"]))
+		d.Set("role_label", stringValue(role["label"]))
+	}
+	d.Set("created", stringValue(address["created"]))
+	d.Set("last_updated", stringValue(address["last_updated"]))
+}
+
 func resourceNetboxPrefixesAvailableIpsUpdate(d *schema.ResourceData, meta interface{}) error {
-	//out := ipam.NewIPAMPrefixesListParams()
 	log.Printf("resourceNetboxPrefixesAvailableIpsUpdate ............ ")
-	// switch {
-	// // Pega por prefix_id
-	// case d.Get("address_id").(int) != 0: // Obrigatório
-	// 	var parm = ipam.NewIPAMIPAddressesUpdateParams()
-	// 	parm.SetID(int64(d.Get("prefixes_id").(int)))
-	// 	//(&&meta).IPAM.IPAMPrefixesRead(parm,nil)
-	//
-	// 	var entrada = models.WritableIPAddress{}
-	// 	entrada.Address = d.Get("address").(*string)
-	// 	entrada.Created = d.Get("created").(strfmt.Date)
-	// 	entrada.CustomFields = d.Get("custom_field").(interface{})
-	// 	entrada.Description = d.Get("description").(string)
-	// 	entrada.ID = d.Get("address_id").(int64)
-	// 	parm.SetData(&entrada)
-	// 	c := meta.(*ProviderNetboxClient).client
-	// 	out, err := c.IPAM.IPAMIPAddressesUpdate(parm, nil)
-	// 	log.Printf("- Executado...\n")
-	// 	if err == nil {
-	//
-	// 		d.SetId(string(out.Payload.ID)) // Sempre setar o ID
-	// 		d.Set("address", out.Payload.Address)
-	// 		d.Set("created", out.Payload.Created)
-	// 		if out.Payload.CustomFields != nil {
-	// 			d.Set("custom_fields", out.Payload.CustomFields)
-	// 		}
-	// 		d.Set("description", out.Payload.Description)
-	// 		d.Set("last_updated", out.Payload.LastUpdated)
-	// 		log.Print("\n")
-	// 	} else {
-	// 		log.Printf("erro na chamada do IPAMIPAddressesRead\n")
-	// 		log.Printf("Err: %v\n", err)
-	// 		log.Print("\n")
-	// 		return err
-	// 	}
-	// 	// Pega por prefix.vlan.vid
-	// default:
-	// 	//return errors.New("No valid parameters found - address_id")
-	// 	log.Printf("Address_id not informed or not exist.")
-	// 	d.SetId("")
-	// 	return nil
-	// }
-
-	return nil
+	data := map[string]interface{}{
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	// vrf_id and tenant_id are computed when not informed, so they are only
+	// sent on changes.
+	if d.HasChange("vrf_id") {
+		data["vrf"] = optionalID(d, "vrf_id")
+	}
+	if d.HasChange("tenant_id") {
+		data["tenant"] = optionalID(d, "tenant_id")
+	}
+	expandIPAssignedObject(d, meta, data)
+	if err := netboxObjectUpdate(d, meta, "ipam/ip-addresses/", data); err != nil {
+		log.Printf("erro na chamada do PATCH ip-addresses\n")
+		log.Printf("Err: %v\n", err)
+		return err
+	}
+	return resourceNetboxPrefixesAvailableIpsRead(d, meta)
 }
+
 func resourceNetboxPrefixesAvailableIpsDelete(d *schema.ResourceData, meta interface{}) error {
 	//out := ipam.NewIPAMPrefixesListParams()
 	log.Printf("resourceNetboxPrefixesAvailableIpsDelete ............ ")
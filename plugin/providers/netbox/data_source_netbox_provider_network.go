@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxProviderNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxProviderNetworkRead,
+		Schema: dataSourceProviderNetworkSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxProviderNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("provider_id"); ok {
+		query.Set("provider_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or provider_id")
+	}
+	network, err := netboxObjectLookup(meta, "circuits/provider-networks/", query, "Provider network")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(network["id"]))
+	setProviderNetworkFields(d, meta, network)
+	return nil
+}
+
+// setProviderNetworkFields sets the attributes of a netbox_provider_network
+// from an API answer.
+func setProviderNetworkFields(d *schema.ResourceData, meta interface{}, network map[string]interface{}) {
+	d.Set("name", stringValue(network["name"]))
+	d.Set("provider_id", nestedID(network["provider"]))
+	d.Set("service_id", stringValue(network["service_id"]))
+	d.Set("description", stringValue(network["description"]))
+	d.Set("comments", stringValue(network["comments"]))
+	d.Set("tags", flattenTags(network["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, network["custom_fields"]))
+}
+
+func bareProviderNetworkSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"provider_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Identifier of the network at the provider, netbox 3.0+.
+		"service_id": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceProviderNetworkSchema() map[string]*schema.Schema {
+	s := bareProviderNetworkSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "provider_id":
+			v.Required = true
+		case "service_id", "description", "comments", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceProviderNetworkSchema returns the schema for the
+// netbox_provider_network data source, searched by name, and optionally by
+// provider.
+func dataSourceProviderNetworkSchema() map[string]*schema.Schema {
+	s := bareProviderNetworkSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "provider_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,182 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// rackStatusLegacy maps the rack status names to the values used before
+// netbox 2.7.
+var rackStatusLegacy = map[string]int{
+	"reserved":   0,
+	"available":  1,
+	"planned":    2,
+	"active":     3,
+	"deprecated": 4,
+}
+
+func dataSourceNetboxRack() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxRackRead,
+		Schema: dataSourceRackSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxRackRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("site_id"); ok {
+		query.Set("site_id", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("facility_id"); ok {
+		query.Set("facility_id", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or site_id or facility_id")
+	}
+	rack, err := netboxObjectLookup(meta, "dcim/racks/", query, "Rack")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(rack["id"]))
+	setRackFields(d, meta, rack)
+	return nil
+}
+
+// setRackFields sets the attributes of a netbox_rack from an API answer.
+func setRackFields(d *schema.ResourceData, meta interface{}, rack map[string]interface{}) {
+	d.Set("name", stringValue(rack["name"]))
+	d.Set("site_id", nestedID(rack["site"]))
+	d.Set("location_id", rackLocationID(rack))
+	d.Set("role_id", nestedID(rack["role"]))
+	d.Set("status", flattenChoice(rack["status"], rackStatusLegacy))
+	d.Set("facility_id", stringValue(rack["facility_id"]))
+	d.Set("tenant_id", nestedID(rack["tenant"]))
+	d.Set("serial", stringValue(rack["serial"]))
+	d.Set("asset_tag", stringValue(rack["asset_tag"]))
+	width, _ := strconv.Atoi(choiceValue(rack["width"]))
+	d.Set("width", width)
+	d.Set("u_height", intValue(rack["u_height"]))
+	d.Set("desc_units", boolValue(rack["desc_units"]))
+	d.Set("comments", stringValue(rack["comments"]))
+	d.Set("tags", flattenTags(rack["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, rack["custom_fields"]))
+	d.Set("created", stringValue(rack["created"]))
+	d.Set("last_updated", stringValue(rack["last_updated"]))
+}
+
+func bareRackSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Location of the rack, sent as group before netbox 2.11.
+		"location_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Local identifier of the rack in its site.
+		"facility_id": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"serial": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"asset_tag": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Rail to rail width in inches: 10, 19, 21 or 23.
+		"width": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"u_height": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Whether the units are numbered top to bottom.
+		"desc_units": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceRackSchema() map[string]*schema.Schema {
+	s := bareRackSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "site_id":
+			v.Required = true
+		case "location_id", "role_id", "facility_id", "tenant_id", "serial", "asset_tag", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "width":
+			v.Optional = true
+			v.Default = 19
+		case "u_height":
+			v.Optional = true
+			v.Default = 42
+			v.ValidateFunc = validation.IntBetween(1, 100)
+		case "desc_units":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceRackSchema returns the schema for the netbox_rack data source,
+// searched by name, site or facility ID.
+func dataSourceRackSchema() map[string]*schema.Schema {
+	s := bareRackSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "site_id", "facility_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// rackLocationID returns the location of a rack of an API answer, that netbox
+// calls group before 2.11.
+func rackLocationID(rack map[string]interface{}) int {
+	if location, ok := rack["location"]; ok {
+		return nestedID(location)
+	}
+	return nestedID(rack["group"])
+}
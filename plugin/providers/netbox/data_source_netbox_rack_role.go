@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxRackRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxRackRoleRead,
+		Schema: dataSourceRackRoleSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxRackRoleRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	role, err := netboxObjectLookup(meta, "dcim/rack-roles/", query, "Rack role")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(role["id"]))
+	setRackRoleFields(d, meta, role)
+	return nil
+}
+
+// setRackRoleFields sets the attributes of a netbox_rack_role from an API
+// answer.
+func setRackRoleFields(d *schema.ResourceData, meta interface{}, role map[string]interface{}) {
+	d.Set("name", stringValue(role["name"]))
+	d.Set("slug", stringValue(role["slug"]))
+	d.Set("color", stringValue(role["color"]))
+	d.Set("description", stringValue(role["description"]))
+	d.Set("tags", flattenTags(role["tags"]))
+}
+
+func bareRackRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceRackRoleSchema() map[string]*schema.Schema {
+	s := bareRackRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "color":
+			v.Required = true
+			v.ValidateFunc = validateColor
+		case "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceRackRoleSchema returns the schema for the netbox_rack_role data
+// source, searched by name or slug.
+func dataSourceRackRoleSchema() map[string]*schema.Schema {
+	s := bareRackRoleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
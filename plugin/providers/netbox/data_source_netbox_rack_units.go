@@ -0,0 +1,114 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// dataSourceNetboxRackUnits returns the free units of a face of a rack, and
+// the lowest position where a device of u_height units fits.
+//
+// The units are read with the raw API, as the go-netbox RackUnit model does
+// not decode the faces of the newer netbox versions.
+func dataSourceNetboxRackUnits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxRackUnitsRead,
+		Schema: map[string]*schema.Schema{
+			"rack_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"face": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "front",
+				ValidateFunc: validation.StringInSlice([]string{"front", "rear"}, false),
+			},
+			// Height of the device to place, used to compute position.
+			"u_height": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			// Unoccupied units, in ascending order. Newer netbox versions
+			// list the half units, like 41.5, next to a half unit device.
+			"free_units": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			// Lowest position of u_height contiguous free units, or 0 when
+			// the device does not fit in the rack.
+			"position": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxRackUnitsRead(d *schema.ResourceData, meta interface{}) error {
+	rackID := strconv.Itoa(d.Get("rack_id").(int))
+	face := d.Get("face").(string)
+	query := url.Values{}
+	query.Set("face", fmt.Sprintf("%v", expandChoice(meta, face, deviceFaceLegacy)))
+	units, err := netboxAPIList(meta, "dcim/racks/"+rackID+"/units/", query)
+	if err != nil {
+		return err
+	}
+	rack := []rackUnit{}
+	free := []float64{}
+	for _, unit := range units {
+		u := rackUnit{
+			position: floatValue(unit["id"]),
+			free:     unit["device"] == nil && !boolValue(unit["occupied"]),
+		}
+		rack = append(rack, u)
+		if u.free {
+			free = append(free, u.position)
+		}
+	}
+	sort.Slice(rack, func(i, j int) bool { return rack[i].position < rack[j].position })
+	sort.Float64s(free)
+	d.SetId(rackID + "/" + face)
+	d.Set("free_units", free)
+	d.Set("position", firstFreePosition(rack, d.Get("u_height").(int)))
+	return nil
+}
+
+// rackUnit is a unit of a rack face, that spans from its position to the
+// position of the next unit, at most one unit above.
+type rackUnit struct {
+	position float64
+	free     bool
+}
+
+// firstFreePosition returns the lowest position of the first height units of
+// contiguous free space in units, sorted in ascending order, or 0 when there
+// is none.
+func firstFreePosition(units []rackUnit, height int) float64 {
+	start, end := 0.0, 0.0
+	for i, u := range units {
+		if !u.free {
+			start = 0
+			continue
+		}
+		if start == 0 || u.position > end {
+			start = u.position
+		}
+		end = u.position + 1
+		if i+1 < len(units) && units[i+1].position < end {
+			end = units[i+1].position
+		}
+		if end-start >= float64(height) {
+			return start
+		}
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxRegion() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxRegionRead,
+		Schema: dataSourceRegionSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxRegionRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	region, err := netboxObjectLookup(meta, "dcim/regions/", query, "Region")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(region["id"]))
+	setRegionFields(d, meta, region)
+	return nil
+}
+
+// setRegionFields sets the attributes of a netbox_region from an API answer.
+func setRegionFields(d *schema.ResourceData, meta interface{}, region map[string]interface{}) {
+	d.Set("name", stringValue(region["name"]))
+	d.Set("slug", stringValue(region["slug"]))
+	d.Set("parent_id", nestedID(region["parent"]))
+	d.Set("description", stringValue(region["description"]))
+}
+
+func bareRegionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceRegionSchema() map[string]*schema.Schema {
+	s := bareRegionSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "parent_id", "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceRegionSchema returns the schema for the netbox_region data source,
+// searched by name or slug.
+func dataSourceRegionSchema() map[string]*schema.Schema {
+	s := bareRegionSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceNetboxRenderedConfigContext returns the config context of a
+// device or a virtual machine, as merged by netbox from the config contexts
+// of its scope and its local context data.
+func dataSourceNetboxRenderedConfigContext() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxRenderedConfigContextRead,
+		Schema: map[string]*schema.Schema{
+			"device_id": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"virtual_machine_id"},
+			},
+			"virtual_machine_id": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"device_id"},
+			},
+			// Merged config context, as a JSON document.
+			"config_context": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxRenderedConfigContextRead(d *schema.ResourceData, meta interface{}) error {
+	var path string
+	switch {
+	case d.Get("device_id").(int) != 0:
+		path = "dcim/devices/" + strconv.Itoa(d.Get("device_id").(int)) + "/"
+	case d.Get("virtual_machine_id").(int) != 0:
+		path = "virtualization/virtual-machines/" + strconv.Itoa(d.Get("virtual_machine_id").(int)) + "/"
+	default:
+		return errors.New("one of device_id or virtual_machine_id must be informed")
+	}
+	var owner map[string]interface{}
+	if err := netboxAPIRequest(meta, "GET", path, nil, &owner); err != nil {
+		return err
+	}
+	context := owner["config_context"]
+	if context == nil {
+		// An empty context is sent as null by some netbox versions.
+		context = map[string]interface{}{}
+	}
+	d.SetId(path)
+	d.Set("config_context", flattenJSON(context))
+	return nil
+}
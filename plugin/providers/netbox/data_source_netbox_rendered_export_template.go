@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceNetboxRenderedExportTemplate renders an export template with the
+// objects of a list endpoint of the API, like a hosts file for a set of
+// prefixes.
+func dataSourceNetboxRenderedExportTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxRenderedExportTemplateRead,
+		Schema: map[string]*schema.Schema{
+			// Name of the export template.
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// API path of the model of the template, like "ipam/prefixes/".
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAPIPath,
+			},
+			// Filters of the objects, like {vrf_id = "1", status = "active"}.
+			"filters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Rendered text.
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetboxRenderedExportTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	for k, v := range d.Get("filters").(map[string]interface{}) {
+		query.Set(k, fmt.Sprintf("%v", v))
+	}
+	query.Set("export", d.Get("name").(string))
+	path := d.Get("path").(string) + "?" + query.Encode()
+	body, err := meta.(*ProviderNetboxClient).rawRequest("GET", path, nil, "*/*")
+	if err != nil {
+		return err
+	}
+	d.SetId(path)
+	d.Set("content", string(body))
+	return nil
+}
+
+// validateAPIPath checks that a value is the path of a list endpoint of the
+// API, like "ipam/prefixes/", without a query.
+func validateAPIPath(v interface{}, k string) (ws []string, errors []error) {
+	path := v.(string)
+	if strings.HasPrefix(path, "/") || !strings.HasSuffix(path, "/") || strings.ContainsAny(path, "?#") {
+		errors = append(errors, fmt.Errorf("%q must be an API path like \"ipam/prefixes/\", got %q", k, path))
+	}
+	return
+}
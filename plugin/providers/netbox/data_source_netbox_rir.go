@@ -0,0 +1,88 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxRir() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxRirRead,
+		Schema: dataSourceRirSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxRirRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	rir, err := netboxObjectLookup(meta, "ipam/rirs/", query, "RIR")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(rir["id"]))
+	setRirFields(d, meta, rir)
+	return nil
+}
+
+// setRirFields sets the attributes of a netbox_rir from an API answer.
+func setRirFields(d *schema.ResourceData, meta interface{}, rir map[string]interface{}) {
+	d.Set("name", stringValue(rir["name"]))
+	d.Set("slug", stringValue(rir["slug"]))
+	d.Set("is_private", boolValue(rir["is_private"]))
+}
+
+func bareRirSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"is_private": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourceRirSchema() map[string]*schema.Schema {
+	s := bareRirSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "is_private":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceRirSchema returns the schema for the netbox_rir data source,
+// searched by name or slug.
+func dataSourceRirSchema() map[string]*schema.Schema {
+	s := bareRirSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
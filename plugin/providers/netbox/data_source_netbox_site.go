@@ -0,0 +1,158 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// siteStatusLegacy maps the site status names to the values used before
+// netbox 2.7.
+var siteStatusLegacy = map[string]int{
+	"active":  1,
+	"planned": 2,
+	"retired": 4,
+}
+
+func dataSourceNetboxSite() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxSiteRead,
+		Schema: dataSourceSiteSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxSiteRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	site, err := netboxObjectLookup(meta, "dcim/sites/", query, "Site")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(site["id"]))
+	setSiteFields(d, meta, site)
+	return nil
+}
+
+// setSiteFields sets the attributes of a netbox_site from an API answer.
+func setSiteFields(d *schema.ResourceData, meta interface{}, site map[string]interface{}) {
+	d.Set("name", stringValue(site["name"]))
+	d.Set("slug", stringValue(site["slug"]))
+	d.Set("status", flattenChoice(site["status"], siteStatusLegacy))
+	d.Set("region_id", nestedID(site["region"]))
+	d.Set("group_id", nestedID(site["group"]))
+	d.Set("tenant_id", nestedID(site["tenant"]))
+	d.Set("facility", stringValue(site["facility"]))
+	d.Set("time_zone", stringValue(site["time_zone"]))
+	d.Set("physical_address", stringValue(site["physical_address"]))
+	d.Set("shipping_address", stringValue(site["shipping_address"]))
+	d.Set("latitude", floatValue(site["latitude"]))
+	d.Set("longitude", floatValue(site["longitude"]))
+	d.Set("description", stringValue(site["description"]))
+	d.Set("tags", flattenTags(site["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, site["custom_fields"]))
+	d.Set("created", stringValue(site["created"]))
+	d.Set("last_updated", stringValue(site["last_updated"]))
+}
+
+func bareSiteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"region_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Site group, netbox 3.0+.
+		"group_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"facility": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"time_zone": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"physical_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"shipping_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"latitude": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		"longitude": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceSiteSchema() map[string]*schema.Schema {
+	s := bareSiteSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "region_id", "group_id", "tenant_id", "facility", "time_zone", "physical_address", "shipping_address", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "latitude":
+			v.Optional = true
+			v.ValidateFunc = validation.FloatBetween(-90, 90)
+		case "longitude":
+			v.Optional = true
+			v.ValidateFunc = validation.FloatBetween(-180, 180)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceSiteSchema returns the schema for the netbox_site data source,
+// searched by name or slug.
+func dataSourceSiteSchema() map[string]*schema.Schema {
+	s := bareSiteSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxSiteGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxSiteGroupRead,
+		Schema: dataSourceSiteGroupSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxSiteGroupRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	group, err := netboxObjectLookup(meta, "dcim/site-groups/", query, "Site group")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(group["id"]))
+	setSiteGroupFields(d, meta, group)
+	return nil
+}
+
+// setSiteGroupFields sets the attributes of a netbox_site_group from an API
+// answer.
+func setSiteGroupFields(d *schema.ResourceData, meta interface{}, group map[string]interface{}) {
+	d.Set("name", stringValue(group["name"]))
+	d.Set("slug", stringValue(group["slug"]))
+	d.Set("parent_id", nestedID(group["parent"]))
+	d.Set("description", stringValue(group["description"]))
+}
+
+func bareSiteGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceSiteGroupSchema() map[string]*schema.Schema {
+	s := bareSiteGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "parent_id", "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceSiteGroupSchema returns the schema for the netbox_site_group data
+// source, searched by name or slug.
+func dataSourceSiteGroupSchema() map[string]*schema.Schema {
+	s := bareSiteGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
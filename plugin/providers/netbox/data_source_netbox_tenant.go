@@ -0,0 +1,107 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxTenant() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxTenantRead,
+		Schema: dataSourceTenantSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxTenantRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	tenant, err := netboxObjectLookup(meta, "tenancy/tenants/", query, "Tenant")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(tenant["id"]))
+	setTenantFields(d, meta, tenant)
+	return nil
+}
+
+// setTenantFields sets the attributes of a netbox_tenant from an API answer.
+func setTenantFields(d *schema.ResourceData, meta interface{}, tenant map[string]interface{}) {
+	d.Set("name", stringValue(tenant["name"]))
+	d.Set("slug", stringValue(tenant["slug"]))
+	d.Set("group_id", nestedID(tenant["group"]))
+	d.Set("description", stringValue(tenant["description"]))
+	d.Set("comments", stringValue(tenant["comments"]))
+	d.Set("tags", flattenTags(tenant["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, tenant["custom_fields"]))
+	d.Set("created", stringValue(tenant["created"]))
+	d.Set("last_updated", stringValue(tenant["last_updated"]))
+}
+
+func bareTenantSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"group_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceTenantSchema() map[string]*schema.Schema {
+	s := bareTenantSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "group_id", "description", "comments", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceTenantSchema returns the schema for the netbox_tenant data source,
+// searched by name or slug.
+func dataSourceTenantSchema() map[string]*schema.Schema {
+	s := bareTenantSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,93 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxTenantGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxTenantGroupRead,
+		Schema: dataSourceTenantGroupSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxTenantGroupRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	switch {
+	case d.Get("name").(string) != "":
+		query.Set("name", d.Get("name").(string))
+	case d.Get("slug").(string) != "":
+		query.Set("slug", d.Get("slug").(string))
+	default:
+		return errors.New("No valid combination of parameters found - need one of name or slug")
+	}
+	group, err := netboxObjectLookup(meta, "tenancy/tenant-groups/", query, "Tenant group")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(group["id"]))
+	setTenantGroupFields(d, meta, group)
+	return nil
+}
+
+// setTenantGroupFields sets the attributes of a netbox_tenant_group from an
+// API answer.
+func setTenantGroupFields(d *schema.ResourceData, meta interface{}, group map[string]interface{}) {
+	d.Set("name", stringValue(group["name"]))
+	d.Set("slug", stringValue(group["slug"]))
+	d.Set("parent_id", nestedID(group["parent"]))
+	d.Set("description", stringValue(group["description"]))
+}
+
+func bareTenantGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Parent group, netbox 2.10+.
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceTenantGroupSchema() map[string]*schema.Schema {
+	s := bareTenantGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "parent_id", "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceTenantGroupSchema returns the schema for the netbox_tenant_group
+// data source, searched by name or slug.
+func dataSourceTenantGroupSchema() map[string]*schema.Schema {
+	s := bareTenantGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,178 @@
+package netbox
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// virtualMachineStatusLegacy maps the virtual machine status names to the
+// values used before netbox 2.7.
+var virtualMachineStatusLegacy = map[string]int{
+	"offline": 0,
+	"active":  1,
+	"staged":  3,
+}
+
+func dataSourceNetboxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxVirtualMachineRead,
+		Schema: dataSourceVirtualMachineSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		query.Set("cluster_id", strconv.Itoa(v.(int)))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or cluster_id")
+	}
+	vm, err := netboxObjectLookup(meta, "virtualization/virtual-machines/", query, "Virtual machine")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(vm["id"]))
+	setVirtualMachineFields(d, meta, vm)
+	return nil
+}
+
+// setVirtualMachineFields sets the attributes of a netbox_virtual_machine from
+// an API answer.
+func setVirtualMachineFields(d *schema.ResourceData, meta interface{}, vm map[string]interface{}) {
+	d.Set("name", stringValue(vm["name"]))
+	d.Set("cluster_id", nestedID(vm["cluster"]))
+	d.Set("site_id", nestedID(vm["site"]))
+	d.Set("role_id", nestedID(vm["role"]))
+	d.Set("platform_id", nestedID(vm["platform"]))
+	d.Set("tenant_id", nestedID(vm["tenant"]))
+	d.Set("status", flattenChoice(vm["status"], virtualMachineStatusLegacy))
+	d.Set("vcpus", floatValue(vm["vcpus"]))
+	d.Set("memory", intValue(vm["memory"]))
+	d.Set("disk", intValue(vm["disk"]))
+	d.Set("primary_ip4_id", nestedID(vm["primary_ip4"]))
+	d.Set("primary_ip6_id", nestedID(vm["primary_ip6"]))
+	d.Set("local_context_data", flattenJSON(vm["local_context_data"]))
+	d.Set("comments", stringValue(vm["comments"]))
+	d.Set("tags", flattenTags(vm["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, vm["custom_fields"]))
+	d.Set("created", stringValue(vm["created"]))
+	d.Set("last_updated", stringValue(vm["last_updated"]))
+}
+
+func bareVirtualMachineSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Cluster of the virtual machine. Netbox before 3.3 requires it.
+		"cluster_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Site of the virtual machine, netbox 3.3+. Before it the site of the
+		// cluster.
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Device role with vm_role.
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"platform_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"vcpus": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		// Memory in MB.
+		"memory": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Disk in GB.
+		"disk": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Primary IPv4 address, that must be assigned to an interface of the
+		// virtual machine. Can also be managed by netbox_primary_ip.
+		"primary_ip4_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"primary_ip6_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Config context data of the virtual machine, as a JSON document.
+		"local_context_data": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceVirtualMachineSchema() map[string]*schema.Schema {
+	s := bareVirtualMachineSchema()
+	for k, v := range s {
+		switch k {
+		case "name":
+			v.Required = true
+		case "cluster_id", "role_id", "platform_id", "tenant_id", "memory", "disk", "comments", "tags", "custom_fields":
+			v.Optional = true
+		case "site_id", "primary_ip4_id", "primary_ip6_id":
+			v.Optional = true
+			v.Computed = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "vcpus":
+			v.Optional = true
+			v.ValidateFunc = validation.FloatBetween(0, 10000)
+		case "local_context_data":
+			v.Optional = true
+			v.ValidateFunc = validation.ValidateJsonString
+			v.DiffSuppressFunc = suppressEquivalentJSON
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceVirtualMachineSchema returns the schema for the
+// netbox_virtual_machine data source, searched by name, and optionally by
+// cluster.
+func dataSourceVirtualMachineSchema() map[string]*schema.Schema {
+	s := bareVirtualMachineSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "cluster_id":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -3,13 +3,10 @@ package netbox
 import (
 	"errors"
 	"log"
+	"net/url"
 	"strconv"
 
-	// "errors"
-
-	"github.com/digitalocean/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform/helper/schema"
-	// "github.com/digitalocean/go-netbox/netbox/client"
 )
 
 func dataSourceNetboxVlans() *schema.Resource {
@@ -19,91 +16,28 @@ func dataSourceNetboxVlans() *schema.Resource {
 	}
 }
 
-// Read will fetch the data of a resource.
+// Read will fetch the data of a resource. The VLAN is read with the raw API,
+// as the go-netbox models do not know the tags of the newer netbox versions.
 func dataSourceNetboxVlansRead(d *schema.ResourceData, meta interface{}) error {
-	//out := ipam.NewIPAMVlansListParams()
-
-	var parm = ipam.NewIPAMVlansListParams()
+	query := url.Values{}
 	switch {
 	case d.Get("vid").(int) != 0:
-		// func (a *Client) IPAMVlansRead(params *IPAMVlansReadParams, authInfo runtime.ClientAuthInfoWriter) (*IPAMVlansReadOK, error) {
 		log.Printf("Ok... localizando por vid: %v\n", d.Get("vid").(int))
-		vid := float64(d.Get("vid").(int))
-		parm.SetVid(&vid)
-		c := meta.(*ProviderNetboxClient).client
-		//parms = ipam.NewIPAMVlansListParams()
-		out, err := c.IPAM.IPAMVlansList(parm, nil)
-		log.Printf("- Executado...\n")
-		if err == nil {
-
-			if *out.Payload.Count == 0 {
-				return errors.New("Vid not found")
-			} else if *out.Payload.Count > 1 {
-				return errors.New("More than one Vid found with name " + d.Get("name").(string))
-			}
-			result := out.Payload.Results[0]
-			d.SetId(strconv.Itoa(int(result.ID)))
-			d.Set("created", result.Created)
-			d.Set("description", result.Description)
-			d.Set("display_name", result.DisplayName)
-			d.Set("group", result.Group)
-			d.Set("vid", *result.Vid)
-			d.Set("last_updated", result.LastUpdated)
-			d.Set("name", *result.Name)
-			d.Set("role", result.Role)
-			d.Set("nested_site", result.Site)
-			d.Set("status", result.Status)
-			d.Set("nested_tenant", result.Tenant)
-			d.Set("custom_fields", result.CustomFields)
-
-		} else {
-			log.Printf("erro na chamada do IPAMVlansList\n")
-			log.Printf("Err: %v\n", err)
-			log.Print("\n")
-			return err
-		}
+		query.Set("vid", strconv.Itoa(d.Get("vid").(int)))
 	case d.Get("name").(string) != "":
-		c := meta.(*ProviderNetboxClient).client
-		parmsl := ipam.NewIPAMVlansListParams()
-		name := d.Get("name").(string)
-		log.Printf("Nome: %v\n", name)
-		parmsl.SetName(&name)
-		log.Printf("Parmsl: %v\n", parmsl)
-		out, err := c.IPAM.IPAMVlansList(parmsl, nil)
-		if err == nil {
-			if *out.Payload.Count == 0 {
-				return errors.New("Name not found - Payload = 0 - need one of vid or name")
-			} else if *out.Payload.Count > 1 {
-				return errors.New("More than one vlan found with name " + d.Get("name").(string))
-			}
-			result := out.Payload.Results[0]
-			d.SetId(strconv.Itoa(int(result.ID)))
-			d.Set("created", result.Created)
-			d.Set("description", result.Description)
-			d.Set("display_name", result.DisplayName)
-			d.Set("group", result.Group)
-			log.Printf("Por nome: result.Vid %v Nome: %v Buscado: %v\n", *result.Vid, *result.Name, name)
-			d.Set("vid", *result.Vid)
-			d.Set("last_updated", result.LastUpdated)
-			d.Set("name", *result.Name)
-			d.Set("role", result.Role)
-			d.Set("nested_site", result.Site)
-			d.Set("status", result.Status)
-			d.Set("nested_tenant", result.Tenant)
-			d.Set("custom_fields", result.CustomFields)
-			log.Printf("Custom Fields: %v\n", result.CustomFields)
-		} else {
-			log.Printf("erro na chamada do IPAMVlansList\n")
-			log.Printf("Err: %v\n", err)
-			return err
-		}
-
-	case d.Get("vid").(int) == 0 && d.Get("name").(string) == "":
-		log.Printf("Informado: vid %v\n", d.Get("vid").(int))
-		log.Printf("Informado: name %v\n", d.Get("name").(string))
-		log.Printf("Informado: d %v\n", d)
+		log.Printf("Nome: %v\n", d.Get("name").(string))
+		query.Set("name", d.Get("name").(string))
+	default:
 		return errors.New("No valid combination of parameters found - need one of vid or name ...")
 	}
+	vlan, err := netboxObjectLookup(meta, "ipam/vlans/", query, "VLAN")
+	if err != nil {
+		log.Printf("erro na chamada do GET vlans\n")
+		log.Printf("Err: %v\n", err)
+		return err
+	}
+	d.SetId(idFromAPI(vlan["id"]))
+	setVlansFields(d, meta, vlan)
 	return nil
 }
 
@@ -134,13 +68,13 @@ func bareVlanSchema() map[string]*schema.Schema {
 			Type: schema.TypeString,
 		},
 		"tenant_id": &schema.Schema{
-			Type: schema.TypeString,
+			Type: schema.TypeInt,
 		},
 		"tenant": &schema.Schema{
 			Type: schema.TypeString,
 		},
-		"role": &schema.Schema{
-			Type: schema.TypeMap,
+		"role_id": &schema.Schema{
+			Type: schema.TypeInt,
 		},
 		"status": &schema.Schema{
 			Type: schema.TypeString,
@@ -156,9 +90,8 @@ func bareVlanSchema() map[string]*schema.Schema {
 		"offset": &schema.Schema{
 			Type: schema.TypeInt,
 		},
-		"custom_fields": &schema.Schema{
-			Type: schema.TypeMap,
-		},
+		"custom_fields": customFieldsSchema(),
+		"tags":          tagsSchema(),
 	}
 }
 
@@ -177,7 +110,8 @@ func resourceVlansSchema() map[string]*schema.Schema {
 			v.Optional = true
 		case "status":
 			v.Optional = true
-		case "role":
+			v.Computed = true
+		case "role_id", "tenant_id", "tags":
 			v.Optional = true
 			//v.ConflictsWith = []string{"ip_address", "subnet_id", "description", "hostname", "custom_field_filter"}
 		default:
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetboxVrf() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxVrfRead,
+		Schema: dataSourceVrfSchema(),
+	}
+}
+
+// Read will fetch the data of a resource.
+func dataSourceNetboxVrfRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] dataSourceNetboxVrfRead: name %v rd %v\n", d.Get("name"), d.Get("rd"))
+	query := url.Values{}
+	if v, ok := d.GetOk("name"); ok {
+		query.Set("name", v.(string))
+	}
+	if v, ok := d.GetOk("rd"); ok {
+		query.Set("rd", v.(string))
+	}
+	if len(query) == 0 {
+		return errors.New("No valid combination of parameters found - need one of name or rd")
+	}
+	vrf, err := netboxObjectLookup(meta, "ipam/vrfs/", query, "VRF")
+	if err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(vrf["id"]))
+	setVrfFields(d, meta, vrf)
+	return nil
+}
+
+// setVrfFields sets the attributes of a netbox_vrf from an API answer.
+func setVrfFields(d *schema.ResourceData, meta interface{}, vrf map[string]interface{}) {
+	d.Set("name", stringValue(vrf["name"]))
+	d.Set("rd", stringValue(vrf["rd"]))
+	d.Set("tenant_id", nestedID(vrf["tenant"]))
+	d.Set("enforce_unique", boolValue(vrf["enforce_unique"]))
+	d.Set("description", stringValue(vrf["description"]))
+	d.Set("import_targets", flattenIDList(vrf["import_targets"]))
+	d.Set("export_targets", flattenIDList(vrf["export_targets"]))
+	d.Set("tags", flattenTags(vrf["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, vrf["custom_fields"]))
+	d.Set("created", stringValue(vrf["created"]))
+	d.Set("last_updated", stringValue(vrf["last_updated"]))
+}
+
+func bareVrfSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"rd": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"enforce_unique": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Route targets, only supported from netbox 2.10.
+		"import_targets": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"export_targets": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceVrfSchema() map[string]*schema.Schema {
+	s := bareVrfSchema()
+	for k, v := range s {
+		switch k {
+		case "name":
+			v.Required = true
+		case "enforce_unique":
+			v.Optional = true
+			v.Default = true
+		case "rd", "tenant_id", "description", "import_targets", "export_targets", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// dataSourceVrfSchema returns the schema for the netbox_vrf data source. The
+// VRF is searched by name and/or rd, all the other fields are computed.
+func dataSourceVrfSchema() map[string]*schema.Schema {
+	s := bareVrfSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "rd":
+			v.Optional = true
+			v.Computed = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// netboxEvents maps the events of the webhooks and event rules to the boolean
+// field of each event before netbox 4.1, and to the event types after it.
+var netboxEvents = []struct {
+	name   string
+	field  string
+	values []string
+}{
+	{"create", "type_create", []string{"object_created"}},
+	{"update", "type_update", []string{"object_updated"}},
+	{"delete", "type_delete", []string{"object_deleted"}},
+	{"job_start", "type_job_start", []string{"job_started"}},
+	{"job_end", "type_job_end", []string{"job_completed", "job_failed", "job_errored"}},
+}
+
+// netboxEventNames lists the valid values of the events attribute.
+var netboxEventNames = []string{"create", "update", "delete", "job_start", "job_end"}
+
+// eventsSchema returns the bare schema of the events attribute, a set of
+// event names.
+func eventsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(netboxEventNames, false),
+		},
+		Set: schema.HashString,
+	}
+}
+
+// expandEvents adds the events attribute to the body of a request: as
+// event_types on netbox 4.1+, and as a boolean field per event before it.
+func expandEvents(d *schema.ResourceData, meta interface{}, data map[string]interface{}) {
+	selected := map[string]bool{}
+	for _, name := range expandStringList(d, "events") {
+		selected[name] = true
+	}
+	if netboxVersionAtLeast(meta, "4.1") {
+		types := []string{}
+		for _, event := range netboxEvents {
+			if selected[event.name] {
+				types = append(types, event.values...)
+			}
+		}
+		data["event_types"] = types
+		return
+	}
+	for _, event := range netboxEvents {
+		data[event.field] = selected[event.name]
+	}
+}
+
+// flattenEvents returns the event names of a webhook or an event rule of an
+// API answer.
+func flattenEvents(o map[string]interface{}) []string {
+	types := map[string]bool{}
+	for _, t := range flattenStringList(o["event_types"]) {
+		types[t] = true
+	}
+	names := []string{}
+	for _, event := range netboxEvents {
+		selected := boolValue(o[event.field])
+		for _, t := range event.values {
+			selected = selected || types[t]
+		}
+		if selected {
+			names = append(names, event.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,562 @@
+package netbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform/helper/mutexkv"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// netboxMutexKV serializes the operations that read then write netbox, like
+// the client-side allocation of the next available VLAN ID.
+var netboxMutexKV = mutexkv.NewMutexKV()
+
+// errNetboxNotFound is returned by the API helpers when netbox answers 404,
+// so that the Read functions can remove the resource from the state.
+var errNetboxNotFound = errors.New("Object not found on netbox")
+
+// netboxAPIPageSize is the page size used when walking list endpoints.
+const netboxAPIPageSize = 1000
+
+// apiURL builds the full URL of an API path, like "ipam/vrfs/1/".
+func (c *ProviderNetboxClient) apiURL(path string) string {
+	return "http://" + c.configuration.Endpoint + "/api/" + strings.TrimPrefix(path, "/")
+}
+
+// rawRequest sends a request to the netbox REST API and returns the body of
+// the answer. It is used for the endpoints (or fields) that the go-netbox
+// client does not know about. in is sent as JSON when it is not nil.
+func (c *ProviderNetboxClient) rawRequest(method string, path string, in interface{}, accept string) ([]byte, error) {
+	var reqBody io.Reader
+	if in != nil {
+		jsonValue, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] %s %s JSON: [%v]\n", method, path, redactedJSON(in, jsonValue))
+		reqBody = bytes.NewBuffer(jsonValue)
+	}
+	req, err := http.NewRequest(method, c.apiURL(path), reqBody)
+	if err != nil {
+		log.Printf("[ERROR] Error occurred creating %s to path [%v]: %v\n", method, path, err)
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("authorization", "Token "+c.configuration.AppID)
+	req.Header.Set("cache-control", "no-cache")
+	req.Header.Set("content-type", "application/json")
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Printf("[ERROR] Error occurred in %s to path [%v]: %v\n", method, path, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if v := resp.Header.Get("API-Version"); v != "" {
+		c.apiVersionMutex.Lock()
+		c.apiVersion = v
+		c.apiVersionMutex.Unlock()
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] %s %s Http Code Response: %v\n", method, path, resp.StatusCode)
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errNetboxNotFound
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return nil, fmt.Errorf("%s %s - Return http code: %d - %s", method, path, resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// redactedKeys are the fields of the request bodies that are not logged, like
+// the secret of a webhook.
+var redactedKeys = []string{"secret", "additional_headers"}
+
+// redactedJSON returns the JSON body of a request for the logs, without the
+// values of the redactedKeys.
+func redactedJSON(in interface{}, jsonValue []byte) string {
+	m, ok := in.(map[string]interface{})
+	if !ok {
+		return string(jsonValue)
+	}
+	redacted := map[string]interface{}{}
+	for k, v := range m {
+		redacted[k] = v
+	}
+	for _, k := range redactedKeys {
+		if v, ok := redacted[k]; ok && v != "" {
+			redacted[k] = "REDACTED"
+		}
+	}
+	b, _ := json.Marshal(redacted)
+	return string(b)
+}
+
+// netboxAPIRequest sends a JSON request to the netbox REST API and decodes
+// the answer into out, when out is not nil.
+func netboxAPIRequest(meta interface{}, method string, path string, in interface{}, out interface{}) error {
+	c := meta.(*ProviderNetboxClient)
+	body, err := c.rawRequest(method, path, in, "application/json")
+	if err != nil {
+		return err
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		log.Printf("[ERROR] Unmarshal of [%v] failed: %v\n", string(body), err)
+		return err
+	}
+	return nil
+}
+
+// netboxAPIList walks all the pages of a list endpoint and returns the
+// results.
+func netboxAPIList(meta interface{}, path string, query url.Values) ([]map[string]interface{}, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	results := []map[string]interface{}{}
+	for {
+		query.Set("limit", strconv.Itoa(netboxAPIPageSize))
+		query.Set("offset", strconv.Itoa(len(results)))
+		var page struct {
+			Count   int                      `json:"count"`
+			Results []map[string]interface{} `json:"results"`
+		}
+		if err := netboxAPIRequest(meta, "GET", path+"?"+query.Encode(), nil, &page); err != nil {
+			return nil, err
+		}
+		results = append(results, page.Results...)
+		if len(page.Results) == 0 || len(results) >= page.Count {
+			return results, nil
+		}
+	}
+}
+
+// netboxAPIVersion returns the API version (like "2.10") reported by netbox.
+// It is empty when netbox does not send the API-Version header.
+func netboxAPIVersion(meta interface{}) string {
+	c := meta.(*ProviderNetboxClient)
+	if v := c.reportedAPIVersion(); v != "" {
+		return v
+	}
+	if _, err := c.rawRequest("GET", "", nil, "application/json"); err != nil {
+		log.Printf("[DEBUG] Could not read the API version: %v\n", err)
+	}
+	return c.reportedAPIVersion()
+}
+
+// reportedAPIVersion returns the API version of the last raw request.
+func (c *ProviderNetboxClient) reportedAPIVersion() string {
+	c.apiVersionMutex.Lock()
+	defer c.apiVersionMutex.Unlock()
+	return c.apiVersion
+}
+
+// netboxVersionAtLeast tells if the API version is equal or newer than the
+// given "major.minor" version. An unknown version is taken as an old one.
+func netboxVersionAtLeast(meta interface{}, version string) bool {
+	return compareVersions(netboxAPIVersion(meta), version) >= 0
+}
+
+// compareVersions compares two "major.minor[.patch]" versions and returns
+// -1, 0 or 1. An empty version is older than any other.
+func compareVersions(a string, b string) int {
+	if a == "" || b == "" {
+		switch {
+		case a == b:
+			return 0
+		case a == "":
+			return -1
+		default:
+			return 1
+		}
+	}
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var ai, bi int
+		if i < len(as) {
+			ai, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bi, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case ai < bi:
+			return -1
+		case ai > bi:
+			return 1
+		}
+	}
+	return 0
+}
+
+// nestedID returns the id of a nested object ({"id": 1, ...}) from an API
+// answer, or 0 when the object is null.
+func nestedID(v interface{}) int {
+	switch n := v.(type) {
+	case map[string]interface{}:
+		return nestedID(n["id"])
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
+// choiceValue returns the value of a choice field, that netbox sends either
+// as a plain value or as an object like {"value": "active", "label": "Active"}.
+func choiceValue(v interface{}) string {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		return choiceValue(c["value"])
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	case string:
+		return c
+	}
+	return ""
+}
+
+// expandChoice converts the value of a choice field, like a status, into the
+// value expected by the netbox version. Before 2.7 netbox uses integer
+// values, given by legacy.
+func expandChoice(meta interface{}, value string, legacy map[string]int) interface{} {
+	if netboxVersionAtLeast(meta, "2.7") {
+		return value
+	}
+	if v, ok := legacy[value]; ok {
+		return v
+	}
+	return value
+}
+
+// flattenChoice converts the value of a choice field of an API answer into
+// the names used after netbox 2.7.
+func flattenChoice(v interface{}, legacy map[string]int) string {
+	value := choiceValue(v)
+	for name, legacyValue := range legacy {
+		if strconv.Itoa(legacyValue) == value {
+			return name
+		}
+	}
+	return value
+}
+
+// optionalID returns the value of an optional id attribute, or nil when it
+// is not set, so that the relation is cleared on netbox.
+func optionalID(d *schema.ResourceData, key string) interface{} {
+	if v, ok := d.GetOk(key); ok && v.(int) != 0 {
+		return v.(int)
+	}
+	return nil
+}
+
+// optionalInt returns the value of an optional int attribute, or nil when it
+// is not set.
+func optionalInt(d *schema.ResourceData, key string) interface{} {
+	if v, ok := d.GetOk(key); ok {
+		return v.(int)
+	}
+	return nil
+}
+
+// optionalString returns the value of an optional string attribute, or nil
+// when it is empty, for the unique fields like an asset tag.
+func optionalString(d *schema.ResourceData, key string) interface{} {
+	if v, ok := d.GetOk(key); ok && v.(string) != "" {
+		return v.(string)
+	}
+	return nil
+}
+
+// optionalChoice returns the value of an optional choice attribute. An unset
+// choice is sent as null before netbox 2.7, when choices are integers, and as
+// an empty string after it.
+func optionalChoice(d *schema.ResourceData, meta interface{}, key string, legacy map[string]int) interface{} {
+	if v, ok := d.GetOk(key); ok {
+		return expandChoice(meta, v.(string), legacy)
+	}
+	if netboxVersionAtLeast(meta, "2.7") {
+		return ""
+	}
+	return nil
+}
+
+// expandIDSet converts a TypeSet of ids into the list expected by the API.
+func expandIDSet(d *schema.ResourceData, key string) []int {
+	ids := []int{}
+	if v, ok := d.GetOk(key); ok {
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, id.(int))
+		}
+	}
+	return ids
+}
+
+// flattenIDList converts a list of nested objects into a list of ids.
+func flattenIDList(v interface{}) []int {
+	ids := []int{}
+	if l, ok := v.([]interface{}); ok {
+		for _, o := range l {
+			ids = append(ids, nestedID(o))
+		}
+	}
+	return ids
+}
+
+// expandStringList converts a TypeList or TypeSet of strings into the list
+// expected by the API.
+func expandStringList(d *schema.ResourceData, key string) []string {
+	l := []string{}
+	var items []interface{}
+	switch v := d.Get(key).(type) {
+	case *schema.Set:
+		items = v.List()
+	case []interface{}:
+		items = v
+	}
+	for _, item := range items {
+		l = append(l, item.(string))
+	}
+	return l
+}
+
+// flattenStringList converts a list of strings of an API answer.
+func flattenStringList(v interface{}) []string {
+	l := []string{}
+	if items, ok := v.([]interface{}); ok {
+		for _, item := range items {
+			l = append(l, stringValue(item))
+		}
+	}
+	return l
+}
+
+// expandJSON decodes a JSON document attribute, like local_context_data, or
+// returns nil when it is empty. The document was checked by
+// validation.ValidateJsonString.
+func expandJSON(d *schema.ResourceData, key string) interface{} {
+	var v interface{}
+	if s := d.Get(key).(string); s != "" {
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			log.Printf("[DEBUG] %s is not a JSON document: %v\n", key, err)
+		}
+	}
+	return v
+}
+
+// flattenJSON encodes a JSON document of an API answer, or returns "" when it
+// is null.
+func flattenJSON(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// suppressEquivalentJSON suppresses the diff of a JSON document attribute
+// when both documents are the same, whatever their formatting.
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if json.Unmarshal([]byte(old), &o) != nil || json.Unmarshal([]byte(new), &n) != nil {
+		return old == new
+	}
+	return reflect.DeepEqual(o, n)
+}
+
+// stringValue returns a string from an API answer, or "" when it is null.
+func stringValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// intValue returns an int from an API answer, or 0 when it is null.
+func intValue(v interface{}) int {
+	if f, ok := v.(float64); ok {
+		return int(f)
+	}
+	return 0
+}
+
+// floatValue returns a float from an API answer, that netbox may send as a
+// number or as a string, or 0 when it is null.
+func floatValue(v interface{}) float64 {
+	switch f := v.(type) {
+	case float64:
+		return f
+	case string:
+		n, _ := strconv.ParseFloat(f, 64)
+		return n
+	}
+	return 0
+}
+
+// optionalFloat returns the value of an optional float attribute, or nil
+// when it is not set.
+func optionalFloat(d *schema.ResourceData, key string) interface{} {
+	if v, ok := d.GetOk(key); ok {
+		return v.(float64)
+	}
+	return nil
+}
+
+// boolValue returns a bool from an API answer, or false when it is null.
+func boolValue(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return false
+}
+
+// idFromAPI converts the id of an API answer into the terraform id.
+func idFromAPI(v interface{}) string {
+	return strconv.Itoa(nestedID(v))
+}
+
+// netboxObjectCreate posts data to a list path, like "ipam/vrfs/", and sets
+// the id of the resource with the id of the new object.
+func netboxObjectCreate(d *schema.ResourceData, meta interface{}, path string, data interface{}) error {
+	var out map[string]interface{}
+	if err := netboxAPIRequest(meta, "POST", path, data, &out); err != nil {
+		return err
+	}
+	d.SetId(idFromAPI(out["id"]))
+	log.Printf("[DEBUG] Created %v%v\n", path, d.Id())
+	netboxJournal(meta, path, d.Id(), "Created")
+	return nil
+}
+
+// netboxObjectRead reads the object of the resource from a list path and
+// calls set with the answer. When the object does not exist anymore the
+// resource is removed from the state.
+func netboxObjectRead(d *schema.ResourceData, meta interface{}, path string, set func(*schema.ResourceData, interface{}, map[string]interface{})) error {
+	var out map[string]interface{}
+	err := netboxAPIRequest(meta, "GET", path+d.Id()+"/", nil, &out)
+	if err == errNetboxNotFound {
+		log.Printf("[DEBUG] %v%v not found, removing from state\n", path, d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	set(d, meta, out)
+	return nil
+}
+
+// netboxObjectUpdate patches the object of the resource with data.
+func netboxObjectUpdate(d *schema.ResourceData, meta interface{}, path string, data interface{}) error {
+	if err := netboxAPIRequest(meta, "PATCH", path+d.Id()+"/", data, nil); err != nil {
+		return err
+	}
+	netboxJournal(meta, path, d.Id(), "Updated")
+	return nil
+}
+
+// netboxObjectDelete deletes the object of the resource. An object already
+// removed from netbox is not an error.
+func netboxObjectDelete(d *schema.ResourceData, meta interface{}, path string) error {
+	err := netboxAPIRequest(meta, "DELETE", path+d.Id()+"/", nil, nil)
+	if err != nil && err != errNetboxNotFound {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// netboxJournal attaches a journal entry to the object id of path after it is
+// created or updated, when the provider has a journal_comment. Netbox deletes
+// the journal of an object with it, so the deletions are only recorded in the
+// change log. Errors are only logged, as not every object has a journal.
+func netboxJournal(meta interface{}, path string, id string, action string) {
+	c := meta.(*ProviderNetboxClient)
+	if c.configuration.JournalComment == "" || path == "extras/journal-entries/" || !netboxVersionAtLeast(meta, "2.10") {
+		return
+	}
+	objectID, err := strconv.Atoi(id)
+	if err != nil {
+		return
+	}
+	data := map[string]interface{}{
+		"assigned_object_type": journalObjectType(path),
+		"assigned_object_id":   objectID,
+		"kind":                 c.configuration.JournalKind,
+		"comments":             action + " by terraform: " + c.configuration.JournalComment,
+	}
+	if err := netboxAPIRequest(meta, "POST", "extras/journal-entries/", data, nil); err != nil {
+		log.Printf("[WARN] Could not attach a journal entry to %v%v: %v\n", path, id, err)
+	}
+}
+
+// journalObjectType returns the type of the objects of an API path, like
+// "ipam.ipaddress" for "ipam/ip-addresses/".
+func journalObjectType(path string) string {
+	if path == "virtualization/interfaces/" {
+		return "virtualization.vminterface"
+	}
+	parts := strings.SplitN(strings.Trim(path, "/"), "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	model := strings.Replace(parts[1], "-", "", -1)
+	switch {
+	case strings.HasSuffix(model, "ies"):
+		model = strings.TrimSuffix(model, "ies") + "y"
+	case strings.HasSuffix(model, "sses"), strings.HasSuffix(model, "xes"):
+		model = strings.TrimSuffix(model, "es")
+	default:
+		model = strings.TrimSuffix(model, "s")
+	}
+	return parts[0] + "." + model
+}
+
+// netboxObjectLookup searches a list path with the query and returns the
+// only object found. kind names the object in the error messages.
+func netboxObjectLookup(meta interface{}, path string, query url.Values, kind string) (map[string]interface{}, error) {
+	results, err := netboxAPIList(meta, path, query)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(results) == 0:
+		return nil, fmt.Errorf("%s not found with %v", kind, query.Encode())
+	case len(results) > 1:
+		return nil, fmt.Errorf("More than one %s found with %v", kind, query.Encode())
+	}
+	return results[0], nil
+}
+
+// isNotFound tells if an error, from the API helpers or from the go-netbox
+// client, is a 404 answer.
+func isNotFound(err error) bool {
+	if err == errNetboxNotFound {
+		return true
+	}
+	if apiErr, ok := err.(*runtime.APIError); ok {
+		return apiErr.Code == http.StatusNotFound
+	}
+	return false
+}
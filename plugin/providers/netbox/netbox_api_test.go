@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"2.10", "2.9", 1},
+		{"2.9", "2.10", -1},
+		{"3.5", "3.5", 0},
+		{"3.5.1", "3.5", 1},
+		{"", "2.9", -1},
+		{"", "", 0},
+	}
+	for _, c := range cases {
+		if got := compareVersions(c.a, c.b); got != c.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestNestedIDAndChoiceValue(t *testing.T) {
+	if id := nestedID(map[string]interface{}{"id": float64(7)}); id != 7 {
+		t.Errorf("nestedID of object = %d, want 7", id)
+	}
+	if id := nestedID(nil); id != 0 {
+		t.Errorf("nestedID of null = %d, want 0", id)
+	}
+	if v := choiceValue(map[string]interface{}{"value": "active", "label": "Active"}); v != "active" {
+		t.Errorf("choiceValue of object = %q, want active", v)
+	}
+	if v := choiceValue(float64(1)); v != "1" {
+		t.Errorf("choiceValue of number = %q, want 1", v)
+	}
+}
+
+func TestFlattenChoice(t *testing.T) {
+	legacy := vlanStatusLegacy
+	if s := flattenChoice(map[string]interface{}{"value": float64(2), "label": "Reserved"}, legacy); s != "reserved" {
+		t.Errorf("flattenChoice of legacy value = %q, want reserved", s)
+	}
+	if s := flattenChoice(map[string]interface{}{"value": "active", "label": "Active"}, legacy); s != "active" {
+		t.Errorf("flattenChoice of value = %q, want active", s)
+	}
+}
+
+func TestJournalObjectType(t *testing.T) {
+	for path, want := range map[string]string{
+		"ipam/ip-addresses/":               "ipam.ipaddress",
+		"ipam/prefixes/":                   "ipam.prefix",
+		"ipam/vlans/":                      "ipam.vlan",
+		"dcim/console-server-ports/":       "dcim.consoleserverport",
+		"circuits/circuit-terminations/":   "circuits.circuittermination",
+		"extras/journal-entries/":          "extras.journalentry",
+		"virtualization/interfaces/":       "virtualization.vminterface",
+		"virtualization/virtual-machines/": "virtualization.virtualmachine",
+	} {
+		if got := journalObjectType(path); got != want {
+			t.Errorf("journalObjectType(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestRedactedJSON(t *testing.T) {
+	in := map[string]interface{}{"name": "hook", "secret": "s3cr3t", "additional_headers": "X-Token: t0k3n"}
+	got := redactedJSON(in, []byte(`{}`))
+	want := `{"additional_headers":"REDACTED","name":"hook","secret":"REDACTED"}`
+	if got != want {
+		t.Errorf("redactedJSON = %s, want %s", got, want)
+	}
+	if in["secret"] != "s3cr3t" {
+		t.Error("redactedJSON should not change the request body")
+	}
+}
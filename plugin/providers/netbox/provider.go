@@ -2,6 +2,7 @@ package netbox
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -10,8 +11,10 @@ var descriptions map[string]string
 // Run before to initiliza vars ...
 func init() {
 	descriptions = map[string]string{
-		"app_id":   "The application ID required for API requests",
-		"endpoint": "The full URL (plus path) to the API endpoint",
+		"app_id":          "The application ID required for API requests",
+		"endpoint":        "The full URL (plus path) to the API endpoint",
+		"journal_comment": "Comment of the journal entries attached to the objects on create and update",
+		"journal_kind":    "Kind of the journal entries attached to the objects",
 		// "timeout":  "Max. wait time should wait for a successful connection to the API",
 	}
 }
@@ -49,6 +52,19 @@ func providerSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: descriptions["Max. wait time should wait for a successful connection to the API"],
 		},
+		"journal_comment": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["journal_comment"],
+		},
+		"journal_kind": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "info",
+			ValidateFunc: validation.StringInSlice(journalEntryKinds, false),
+			Description:  descriptions["journal_kind"],
+		},
 	}
 }
 
@@ -63,6 +79,55 @@ func providerResources() map[string]*schema.Resource {
 		"netbox_vlans":                  resourceNetboxVlans(),
 		"netbox_prefixes":               resourceNetboxPrefixes(),
 		"netbox_prefixes_available_ips": resourceNetboxPrefixesAvailableIps(),
+		"netbox_vrf":                    resourceNetboxVrf(),
+		"netbox_rir":                    resourceNetboxRir(),
+		"netbox_aggregate":              resourceNetboxAggregate(),
+		"netbox_vlan_group":             resourceNetboxVlanGroup(),
+		"netbox_available_vlan":         resourceNetboxAvailableVlan(),
+		"netbox_ipam_role":              resourceNetboxIpamRole(),
+		"netbox_ip_range":               resourceNetboxIPRange(),
+		"netbox_service":                resourceNetboxService(),
+		"netbox_region":                 resourceNetboxRegion(),
+		"netbox_site_group":             resourceNetboxSiteGroup(),
+		"netbox_site":                   resourceNetboxSite(),
+		"netbox_tenant_group":           resourceNetboxTenantGroup(),
+		"netbox_tenant":                 resourceNetboxTenant(),
+		"netbox_tag":                    resourceNetboxTag(),
+		"netbox_custom_field":           resourceNetboxCustomField(),
+		"netbox_manufacturer":           resourceNetboxManufacturer(),
+		"netbox_device_type":            resourceNetboxDeviceType(),
+		"netbox_device_role":            resourceNetboxDeviceRole(),
+		"netbox_platform":               resourceNetboxPlatform(),
+		"netbox_device":                 resourceNetboxDevice(),
+		"netbox_rack_role":              resourceNetboxRackRole(),
+		"netbox_location":               resourceNetboxLocation(),
+		"netbox_rack":                   resourceNetboxRack(),
+		"netbox_device_interface":       resourceNetboxDeviceInterface(),
+		"netbox_vm_interface":           resourceNetboxVMInterface(),
+		"netbox_cluster_type":           resourceNetboxClusterType(),
+		"netbox_cluster_group":          resourceNetboxClusterGroup(),
+		"netbox_cluster":                resourceNetboxCluster(),
+		"netbox_virtual_machine":        resourceNetboxVirtualMachine(),
+		"netbox_primary_ip":             resourceNetboxPrimaryIP(),
+		"netbox_cable":                  resourceNetboxCable(),
+		"netbox_console_port":           resourceNetboxConsolePort(),
+		"netbox_console_server_port":    resourceNetboxConsoleServerPort(),
+		"netbox_power_port":             resourceNetboxPowerPort(),
+		"netbox_power_outlet":           resourceNetboxPowerOutlet(),
+		"netbox_rear_port":              resourceNetboxRearPort(),
+		"netbox_front_port":             resourceNetboxFrontPort(),
+		"netbox_power_panel":            resourceNetboxPowerPanel(),
+		"netbox_power_feed":             resourceNetboxPowerFeed(),
+		"netbox_circuit_provider":       resourceNetboxCircuitProvider(),
+		"netbox_provider_network":       resourceNetboxProviderNetwork(),
+		"netbox_circuit_type":           resourceNetboxCircuitType(),
+		"netbox_circuit":                resourceNetboxCircuit(),
+		"netbox_circuit_termination":    resourceNetboxCircuitTermination(),
+		"netbox_config_context":         resourceNetboxConfigContext(),
+		"netbox_export_template":        resourceNetboxExportTemplate(),
+		"netbox_webhook":                resourceNetboxWebhook(),
+		"netbox_event_rule":             resourceNetboxEventRule(),
+		"netbox_journal_entry":          resourceNetboxJournalEntry(),
 	}
 }
 
@@ -75,8 +140,49 @@ func providerResources() map[string]*schema.Resource {
 
 func providerDataSourcesMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"netbox_vlans":    dataSourceNetboxVlans(),
-		"netbox_prefixes": dataSourceNetboxPrefixes(),
+		"netbox_vlans":        dataSourceNetboxVlans(),
+		"netbox_prefixes":     dataSourceNetboxPrefixes(),
+		"netbox_vrf":          dataSourceNetboxVrf(),
+		"netbox_rir":          dataSourceNetboxRir(),
+		"netbox_aggregate":    dataSourceNetboxAggregate(),
+		"netbox_ipam_role":    dataSourceNetboxIpamRole(),
+		"netbox_ip_range":     dataSourceNetboxIPRange(),
+		"netbox_region":       dataSourceNetboxRegion(),
+		"netbox_site_group":   dataSourceNetboxSiteGroup(),
+		"netbox_site":         dataSourceNetboxSite(),
+		"netbox_tenant_group": dataSourceNetboxTenantGroup(),
+		"netbox_tenant":       dataSourceNetboxTenant(),
+		"netbox_manufacturer": dataSourceNetboxManufacturer(),
+		"netbox_device_type":  dataSourceNetboxDeviceType(),
+		"netbox_device_role":  dataSourceNetboxDeviceRole(),
+		"netbox_platform":     dataSourceNetboxPlatform(),
+		"netbox_device":       dataSourceNetboxDevice(),
+		"netbox_rack_role":    dataSourceNetboxRackRole(),
+		"netbox_location":     dataSourceNetboxLocation(),
+		"netbox_rack":         dataSourceNetboxRack(),
+		"netbox_rack_units":   dataSourceNetboxRackUnits(),
+
+		"netbox_cluster_type":    dataSourceNetboxClusterType(),
+		"netbox_cluster_group":   dataSourceNetboxClusterGroup(),
+		"netbox_cluster":         dataSourceNetboxCluster(),
+		"netbox_virtual_machine": dataSourceNetboxVirtualMachine(),
+
+		"netbox_cable_trace": dataSourceNetboxCableTrace(),
+
+		"netbox_power_panel": dataSourceNetboxPowerPanel(),
+		"netbox_power_feed":  dataSourceNetboxPowerFeed(),
+
+		"netbox_circuit_provider":    dataSourceNetboxCircuitProvider(),
+		"netbox_provider_network":    dataSourceNetboxProviderNetwork(),
+		"netbox_circuit_type":        dataSourceNetboxCircuitType(),
+		"netbox_circuit":             dataSourceNetboxCircuit(),
+		"netbox_circuit_termination": dataSourceNetboxCircuitTermination(),
+
+		"netbox_rendered_config_context": dataSourceNetboxRenderedConfigContext(),
+
+		"netbox_rendered_export_template": dataSourceNetboxRenderedExportTemplate(),
+
+		"netbox_object_changes": dataSourceNetboxObjectChanges(),
 	}
 }
 
@@ -85,8 +191,10 @@ func providerDataSourcesMap() map[string]*schema.Resource {
 // interacts with the API.
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		AppID:    d.Get("app_id").(string),
-		Endpoint: d.Get("endpoint").(string),
+		AppID:          d.Get("app_id").(string),
+		Endpoint:       d.Get("endpoint").(string),
+		JournalComment: d.Get("journal_comment").(string),
+		JournalKind:    d.Get("journal_kind").(string),
 		// Timeout:  d.Get("timeout").(string),
 	}
 	return config.Client()
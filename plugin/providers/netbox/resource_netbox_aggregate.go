@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxAggregate returns the resource structure for the
+// netbox_aggregate resource.
+func resourceNetboxAggregate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAggregateCreate,
+		Read:   resourceNetboxAggregateRead,
+		Update: resourceNetboxAggregateUpdate,
+		Delete: resourceNetboxAggregateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceAggregateSchema(),
+	}
+}
+
+// expandAggregate builds the body of the aggregate create and update
+// requests.
+func expandAggregate(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"prefix":        d.Get("prefix").(string),
+		"rir":           d.Get("rir_id").(int),
+		"date_added":    optionalString(d, "date_added"),
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxAggregateCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxAggregateCreate: %v\n", d.Get("prefix"))
+	if err := netboxObjectCreate(d, meta, "ipam/aggregates/", expandAggregate(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxAggregateRead(d, meta)
+}
+
+func resourceNetboxAggregateRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/aggregates/", setAggregateFields)
+}
+
+func resourceNetboxAggregateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxAggregateUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/aggregates/", expandAggregate(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxAggregateRead(d, meta)
+}
+
+func resourceNetboxAggregateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxAggregateDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/aggregates/")
+}
@@ -0,0 +1,47 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxAggregateConfig = `
+resource "netbox_rir" "rfc1918" {
+  name       = "terraform-test-rfc1918"
+  slug       = "terraform-test-rfc1918"
+  is_private = true
+}
+
+resource "netbox_aggregate" "ten" {
+  prefix      = "10.0.0.0/8"
+  rir_id      = "${netbox_rir.rfc1918.id}"
+  date_added  = "2018-09-01"
+  description = "Terraform test aggregate"
+}
+
+data "netbox_aggregate" "ten" {
+  prefix = "${netbox_aggregate.ten.prefix}"
+}
+
+data "netbox_rir" "rfc1918" {
+  slug = "${netbox_rir.rfc1918.slug}"
+}
+`
+
+func TestAccResourceNetboxAggregate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxAggregateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_aggregate.ten", "date_added", "2018-09-01"),
+					resource.TestCheckResourceAttrPair("data.netbox_aggregate.ten", "rir_id", "netbox_rir.rfc1918", "id"),
+					resource.TestCheckResourceAttr("data.netbox_rir.rfc1918", "is_private", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// vlanStatusLegacy maps the VLAN status names to the values used before
+// netbox 2.7.
+var vlanStatusLegacy = map[string]int{
+	"active":     1,
+	"reserved":   2,
+	"deprecated": 3,
+}
+
+// resourceNetboxAvailableVlan returns the resource structure for the
+// netbox_available_vlan resource, that creates a VLAN with the lowest unused
+// VLAN ID of a group.
+func resourceNetboxAvailableVlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableVlanCreate,
+		Read:   resourceNetboxAvailableVlanRead,
+		Update: resourceNetboxAvailableVlanUpdate,
+		Delete: resourceNetboxAvailableVlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceAvailableVlanSchema(),
+	}
+}
+
+func bareAvailableVlanSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"vid": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+	}
+}
+
+func resourceAvailableVlanSchema() map[string]*schema.Schema {
+	s := bareAvailableVlanSchema()
+	for k, v := range s {
+		switch k {
+		case "group_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name":
+			v.Required = true
+		case "status":
+			v.Optional = true
+			v.Default = "active"
+		case "tenant_id", "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// expandAvailableVlan builds the body of the VLAN create and update requests.
+func expandAvailableVlan(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"status":      expandChoice(meta, d.Get("status").(string), vlanStatusLegacy),
+		"tenant":      optionalID(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+// setAvailableVlanFields sets the attributes of a netbox_available_vlan from
+// an API answer.
+func setAvailableVlanFields(d *schema.ResourceData, meta interface{}, vlan map[string]interface{}) {
+	d.Set("group_id", nestedID(vlan["group"]))
+	d.Set("vid", intValue(vlan["vid"]))
+	d.Set("name", stringValue(vlan["name"]))
+	d.Set("status", flattenChoice(vlan["status"], vlanStatusLegacy))
+	d.Set("tenant_id", nestedID(vlan["tenant"]))
+	d.Set("description", stringValue(vlan["description"]))
+	d.Set("created", stringValue(vlan["created"]))
+	d.Set("last_updated", stringValue(vlan["last_updated"]))
+	d.Set("tags", flattenTags(vlan["tags"]))
+}
+
+// nextAvailableVid scans the VLANs of a group and returns the lowest VLAN ID
+// not used yet, inside the range of the group when netbox knows about it.
+func nextAvailableVid(meta interface{}, groupID int) (int, error) {
+	var group map[string]interface{}
+	if err := netboxAPIRequest(meta, "GET", "ipam/vlan-groups/"+strconv.Itoa(groupID)+"/", nil, &group); err != nil {
+		return 0, err
+	}
+	minVid, maxVid := 1, 4094
+	if v := intValue(group["min_vid"]); v != 0 {
+		minVid = v
+	}
+	if v := intValue(group["max_vid"]); v != 0 {
+		maxVid = v
+	}
+	vlans, err := netboxAPIList(meta, "ipam/vlans/", url.Values{"group_id": {strconv.Itoa(groupID)}})
+	if err != nil {
+		return 0, err
+	}
+	used := map[int]bool{}
+	for _, vlan := range vlans {
+		used[intValue(vlan["vid"])] = true
+	}
+	for vid := minVid; vid <= maxVid; vid++ {
+		if !used[vid] {
+			return vid, nil
+		}
+	}
+	return 0, fmt.Errorf("No VLAN ID available in the VLAN group %d", groupID)
+}
+
+// Create asks netbox for the next available VLAN of the group. The
+// available-vlans endpoint exists since netbox 3.0; on the older versions the
+// VLAN ID is chosen here, holding a lock on the group so that the VLANs
+// created by the same run do not get the same VLAN ID.
+func resourceNetboxAvailableVlanCreate(d *schema.ResourceData, meta interface{}) error {
+	groupID := strconv.Itoa(d.Get("group_id").(int))
+	log.Printf("[DEBUG] resourceNetboxAvailableVlanCreate: group %v\n", groupID)
+	data := expandAvailableVlan(d, meta)
+	var vlan map[string]interface{}
+	if netboxVersionAtLeast(meta, "3.0") {
+		if err := netboxAPIRequest(meta, "POST", "ipam/vlan-groups/"+groupID+"/available-vlans/", data, &vlan); err != nil {
+			return err
+		}
+	} else {
+		log.Printf("[DEBUG] available-vlans not supported, scanning the VLAN group %v\n", groupID)
+		lockKey := "vlan-group/" + groupID
+		netboxMutexKV.Lock(lockKey)
+		defer netboxMutexKV.Unlock(lockKey)
+
+		vid, err := nextAvailableVid(meta, d.Get("group_id").(int))
+		if err != nil {
+			return err
+		}
+		data["group"] = d.Get("group_id").(int)
+		data["vid"] = vid
+		if err := netboxAPIRequest(meta, "POST", "ipam/vlans/", data, &vlan); err != nil {
+			return err
+		}
+	}
+	d.SetId(idFromAPI(vlan["id"]))
+	netboxJournal(meta, "ipam/vlans/", d.Id(), "Created")
+	return resourceNetboxAvailableVlanRead(d, meta)
+}
+
+func resourceNetboxAvailableVlanRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/vlans/", setAvailableVlanFields)
+}
+
+func resourceNetboxAvailableVlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxAvailableVlanUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/vlans/", expandAvailableVlan(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxAvailableVlanRead(d, meta)
+}
+
+func resourceNetboxAvailableVlanDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxAvailableVlanDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/vlans/")
+}
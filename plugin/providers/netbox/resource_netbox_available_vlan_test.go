@@ -0,0 +1,63 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccResourceNetboxAvailableVlanConfig = `
+resource "netbox_vlan_group" "group" {
+  name = "terraform-test-group"
+  slug = "terraform-test-group"
+}
+
+resource "netbox_available_vlan" "first" {
+  group_id = "${netbox_vlan_group.group.id}"
+  name     = "terraform-test-first"
+}
+
+resource "netbox_available_vlan" "second" {
+  group_id = "${netbox_vlan_group.group.id}"
+  name     = "terraform-test-second"
+}
+`
+
+func TestAccResourceNetboxAvailableVlan(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxAvailableVlanConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netbox_available_vlan.first", "vid"),
+					resource.TestCheckResourceAttrSet("netbox_available_vlan.second", "vid"),
+					testAccCheckNetboxAvailableVlansDiffer("netbox_available_vlan.first", "netbox_available_vlan.second"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.first", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNetboxAvailableVlansDiffer checks that two netbox_available_vlan
+// got different VLAN IDs.
+func testAccCheckNetboxAvailableVlansDiffer(a, b string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		vids := []string{}
+		for _, n := range []string{a, b} {
+			rs, ok := s.RootModule().Resources[n]
+			if !ok {
+				return fmt.Errorf("Not found: %s", n)
+			}
+			vids = append(vids, rs.Primary.Attributes["vid"])
+		}
+		if vids[0] == vids[1] {
+			return fmt.Errorf("%s and %s got the same vid %s", a, b, vids[0])
+		}
+		return nil
+	}
+}
@@ -0,0 +1,228 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxCable returns the resource structure for the netbox_cable
+// resource, a connection between two terminations.
+func resourceNetboxCable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCableCreate,
+		Read:   resourceNetboxCableRead,
+		Update: resourceNetboxCableUpdate,
+		Delete: resourceNetboxCableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceCableSchema(),
+	}
+}
+
+// expandCable builds the body of the cable create and update requests.
+func expandCable(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"type":          d.Get("type").(string),
+		"status":        d.Get("status").(string),
+		"label":         d.Get("label").(string),
+		"color":         d.Get("color").(string),
+		"length":        optionalFloat(d, "length"),
+		"length_unit":   optionalChoice(d, meta, "length_unit", cableLengthUnitLegacy),
+		"tenant":        optionalID(d, "tenant_id"),
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "3.3") {
+		for _, side := range []string{"a", "b"} {
+			data[side+"_terminations"] = []map[string]interface{}{{
+				"object_type": d.Get(side + "_termination_type").(string),
+				"object_id":   d.Get(side + "_termination_id").(int),
+			}}
+		}
+	} else {
+		for _, side := range []string{"a", "b"} {
+			data["termination_"+side+"_type"] = d.Get(side + "_termination_type").(string)
+			data["termination_"+side+"_id"] = d.Get(side + "_termination_id").(int)
+		}
+	}
+	return data
+}
+
+func resourceNetboxCableCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCableCreate: %v\n", d.Get("label"))
+	if err := netboxObjectCreate(d, meta, "dcim/cables/", expandCable(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCableRead(d, meta)
+}
+
+func resourceNetboxCableRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/cables/", setCableFields)
+}
+
+func resourceNetboxCableUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCableUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/cables/", expandCable(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCableRead(d, meta)
+}
+
+func resourceNetboxCableDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCableDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/cables/")
+}
+
+// cableTerminationPaths are the paths of the objects a cable can be connected
+// to, by type.
+var cableTerminationPaths = map[string]string{
+	"dcim.interface":              "dcim/interfaces/",
+	"dcim.frontport":              "dcim/front-ports/",
+	"dcim.rearport":               "dcim/rear-ports/",
+	"dcim.consoleport":            "dcim/console-ports/",
+	"dcim.consoleserverport":      "dcim/console-server-ports/",
+	"dcim.powerport":              "dcim/power-ports/",
+	"dcim.poweroutlet":            "dcim/power-outlets/",
+	"dcim.powerfeed":              "dcim/power-feeds/",
+	"circuits.circuittermination": "circuits/circuit-terminations/",
+}
+
+// cableTerminationTypes are the types of the objects a cable can be connected
+// to.
+var cableTerminationTypes = []string{
+	"dcim.interface", "dcim.frontport", "dcim.rearport", "dcim.consoleport",
+	"dcim.consoleserverport", "dcim.powerport", "dcim.poweroutlet",
+	"dcim.powerfeed", "circuits.circuittermination",
+}
+
+// cableLengthUnitLegacy maps the cable length units to the values used before
+// netbox 2.7.
+var cableLengthUnitLegacy = map[string]int{
+	"m":  1200,
+	"cm": 1100,
+	"ft": 2100,
+	"in": 2000,
+}
+
+// setCableFields sets the attributes of a netbox_cable from an API answer.
+func setCableFields(d *schema.ResourceData, meta interface{}, cable map[string]interface{}) {
+	d.Set("type", choiceValue(cable["type"]))
+	d.Set("status", choiceValue(cable["status"]))
+	d.Set("label", stringValue(cable["label"]))
+	d.Set("color", stringValue(cable["color"]))
+	d.Set("length", floatValue(cable["length"]))
+	d.Set("length_unit", flattenChoice(cable["length_unit"], cableLengthUnitLegacy))
+	d.Set("tenant_id", nestedID(cable["tenant"]))
+	d.Set("description", stringValue(cable["description"]))
+	d.Set("tags", flattenTags(cable["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, cable["custom_fields"]))
+	d.Set("created", stringValue(cable["created"]))
+	d.Set("last_updated", stringValue(cable["last_updated"]))
+	for _, side := range []string{"a", "b"} {
+		terminationType, terminationID := cableTermination(cable, side)
+		d.Set(side+"_termination_type", terminationType)
+		d.Set(side+"_termination_id", terminationID)
+	}
+}
+
+func bareCableSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Type of the A end, like "dcim.interface".
+		"a_termination_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"a_termination_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Type of the B end, like "dcim.interface".
+		"b_termination_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"b_termination_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Type of the cable, like "cat6" or "smf".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"status": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"length": &schema.Schema{
+			Type: schema.TypeFloat,
+		},
+		"length_unit": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Tenant of the cable, netbox 3.3+.
+		"tenant_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceCableSchema() map[string]*schema.Schema {
+	s := bareCableSchema()
+	for k, v := range s {
+		switch k {
+		case "a_termination_type", "b_termination_type":
+			v.Required = true
+			v.ForceNew = true
+			v.ValidateFunc = validation.StringInSlice(cableTerminationTypes, false)
+		case "a_termination_id", "b_termination_id":
+			v.Required = true
+			v.ForceNew = true
+		case "type", "label", "length", "tenant_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "status":
+			v.Optional = true
+			v.Default = "connected"
+		case "color":
+			v.Optional = true
+			v.ValidateFunc = validateColor
+		case "length_unit":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"km", "m", "cm", "mi", "ft", "in"}, false)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// cableTermination returns the type and the ID of the termination of a side
+// ("a" or "b") of a cable of an API answer. Netbox 3.3+ sends a list of
+// terminations, of which the first one is used.
+func cableTermination(cable map[string]interface{}, side string) (string, int) {
+	if terminations, ok := cable[side+"_terminations"].([]interface{}); ok {
+		if len(terminations) == 0 {
+			return "", 0
+		}
+		t, _ := terminations[0].(map[string]interface{})
+		return stringValue(t["object_type"]), nestedID(t["object_id"])
+	}
+	return stringValue(cable["termination_"+side+"_type"]), nestedID(cable["termination_"+side+"_id"])
+}
@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxCableConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-cable-dc"
+  slug = "terraform-test-cable-dc"
+}
+
+resource "netbox_manufacturer" "acme" {
+  name = "terraform-test-cable-acme"
+  slug = "terraform-test-cable-acme"
+}
+
+resource "netbox_device_type" "switch" {
+  manufacturer_id = "${netbox_manufacturer.acme.id}"
+  model           = "terraform-test-cable-switch"
+  slug            = "terraform-test-cable-switch"
+}
+
+resource "netbox_device_role" "network" {
+  name  = "terraform-test-cable-network"
+  slug  = "terraform-test-cable-network"
+  color = "9e9e9e"
+}
+
+resource "netbox_device" "sw" {
+  count          = 2
+  name           = "terraform-test-cable-sw${count.index}"
+  device_type_id = "${netbox_device_type.switch.id}"
+  role_id        = "${netbox_device_role.network.id}"
+  site_id        = "${netbox_site.dc.id}"
+}
+
+resource "netbox_device_interface" "uplink" {
+  count     = 2
+  device_id = "${element(netbox_device.sw.*.id, count.index)}"
+  name      = "uplink"
+  type      = "10gbase-x-sfpp"
+}
+
+resource "netbox_cable" "uplink" {
+  a_termination_type = "dcim.interface"
+  a_termination_id   = "${netbox_device_interface.uplink.0.id}"
+  b_termination_type = "dcim.interface"
+  b_termination_id   = "${netbox_device_interface.uplink.1.id}"
+  type               = "smf"
+  label              = "terraform-test-uplink"
+  color              = "ffeb3b"
+}
+
+data "netbox_cable_trace" "uplink" {
+  termination_id = "${netbox_cable.uplink.a_termination_id}"
+}
+`
+
+func TestAccResourceNetboxCable(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxCableConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cable.uplink", "status", "connected"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.uplink", "far_end_id", "netbox_device_interface.uplink.1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.uplink", "far_end_type", "dcim.interface"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.uplink", "cable_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestCableTermination(t *testing.T) {
+	old := map[string]interface{}{"termination_a_type": "dcim.interface", "termination_a_id": 1.0}
+	if kind, id := cableTermination(old, "a"); kind != "dcim.interface" || id != 1 {
+		t.Errorf("cableTermination(%v) = %q, %d", old, kind, id)
+	}
+	current := map[string]interface{}{"b_terminations": []interface{}{
+		map[string]interface{}{"object_type": "dcim.frontport", "object_id": 2.0},
+	}}
+	if kind, id := cableTermination(current, "b"); kind != "dcim.frontport" || id != 2 {
+		t.Errorf("cableTermination(%v) = %q, %d", current, kind, id)
+	}
+}
+
+func TestCableTerminationType(t *testing.T) {
+	if got := cableTerminationType("http://netbox/api/dcim/rear-ports/3/"); got != "dcim.rearport" {
+		t.Errorf("cableTerminationType = %q, want dcim.rearport", got)
+	}
+	if got := cableTerminationType("http://netbox/api/circuits/circuit-terminations/4/"); got != "circuits.circuittermination" {
+		t.Errorf("cableTerminationType = %q, want circuits.circuittermination", got)
+	}
+}
@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxCircuit returns the resource structure for the netbox_circuit
+// resource.
+func resourceNetboxCircuit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitCreate,
+		Read:   resourceNetboxCircuitRead,
+		Update: resourceNetboxCircuitUpdate,
+		Delete: resourceNetboxCircuitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceCircuitSchema(),
+	}
+}
+
+// expandCircuit builds the body of the circuit create and update requests.
+func expandCircuit(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"cid":           d.Get("cid").(string),
+		"provider":      optionalID(d, "provider_id"),
+		"type":          optionalID(d, "type_id"),
+		"status":        expandChoice(meta, d.Get("status").(string), circuitStatusLegacy),
+		"tenant":        optionalID(d, "tenant_id"),
+		"install_date":  optionalString(d, "install_date"),
+		"commit_rate":   optionalInt(d, "commit_rate"),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxCircuitCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitCreate: %v\n", d.Get("cid"))
+	if err := netboxObjectCreate(d, meta, "circuits/circuits/", expandCircuit(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitRead(d, meta)
+}
+
+func resourceNetboxCircuitRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "circuits/circuits/", setCircuitFields)
+}
+
+func resourceNetboxCircuitUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "circuits/circuits/", expandCircuit(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitRead(d, meta)
+}
+
+func resourceNetboxCircuitDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "circuits/circuits/")
+}
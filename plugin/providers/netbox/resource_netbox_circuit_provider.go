@@ -0,0 +1,63 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxCircuitProvider returns the resource structure for the
+// netbox_circuit_provider resource, the carrier or the transit provider of
+// circuits.
+func resourceNetboxCircuitProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitProviderCreate,
+		Read:   resourceNetboxCircuitProviderRead,
+		Update: resourceNetboxCircuitProviderUpdate,
+		Delete: resourceNetboxCircuitProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceCircuitProviderSchema(),
+	}
+}
+
+// expandCircuitProvider builds the body of the circuit provider create and
+// update requests.
+func expandCircuitProvider(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"slug":          d.Get("slug").(string),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitProviderCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "circuits/providers/", expandCircuitProvider(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitProviderRead(d, meta)
+}
+
+func resourceNetboxCircuitProviderRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "circuits/providers/", setCircuitProviderFields)
+}
+
+func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitProviderUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "circuits/providers/", expandCircuitProvider(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitProviderRead(d, meta)
+}
+
+func resourceNetboxCircuitProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitProviderDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "circuits/providers/")
+}
@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxCircuitTermination returns the resource structure for the
+// netbox_circuit_termination resource, the A or Z end of a circuit, on a site
+// or on a provider network.
+func resourceNetboxCircuitTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitTerminationCreate,
+		Read:   resourceNetboxCircuitTerminationRead,
+		Update: resourceNetboxCircuitTerminationUpdate,
+		Delete: resourceNetboxCircuitTerminationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxCircuitTerminationCustomizeDiff,
+
+		Schema: resourceCircuitTerminationSchema(),
+	}
+}
+
+// resourceNetboxCircuitTerminationCustomizeDiff checks at plan time the custom
+// fields and that the termination is on a site or on a provider network.
+// ConflictsWith only covers the case of both.
+func resourceNetboxCircuitTerminationCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomFields(d, meta); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("site_id") || !d.NewValueKnown("provider_network_id") {
+		return nil
+	}
+	if d.Get("site_id").(int) == 0 && d.Get("provider_network_id").(int) == 0 {
+		return errors.New("one of site_id or provider_network_id must be informed")
+	}
+	return nil
+}
+
+// expandCircuitTermination builds the body of the circuit termination create
+// and update requests.
+func expandCircuitTermination(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"circuit":        optionalID(d, "circuit_id"),
+		"term_side":      d.Get("term_side").(string),
+		"port_speed":     optionalInt(d, "port_speed"),
+		"upstream_speed": optionalInt(d, "upstream_speed"),
+		"xconnect_id":    d.Get("xconnect_id").(string),
+		"pp_info":        d.Get("pp_info").(string),
+		"description":    d.Get("description").(string),
+		"mark_connected": d.Get("mark_connected").(bool),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+	site, network := optionalID(d, "site_id"), optionalID(d, "provider_network_id")
+	switch {
+	case netboxVersionAtLeast(meta, "4.2") && network != nil:
+		data["termination_type"] = "circuits.providernetwork"
+		data["termination_id"] = network
+	case netboxVersionAtLeast(meta, "4.2"):
+		data["termination_type"] = "dcim.site"
+		data["termination_id"] = site
+	default:
+		data["site"] = site
+		data["provider_network"] = network
+	}
+	return data
+}
+
+func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTerminationCreate: %v\n", d.Get("term_side"))
+	if err := netboxObjectCreate(d, meta, "circuits/circuit-terminations/", expandCircuitTermination(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitTerminationRead(d, meta)
+}
+
+func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "circuits/circuit-terminations/", setCircuitTerminationFields)
+}
+
+func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTerminationUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "circuits/circuit-terminations/", expandCircuitTermination(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitTerminationRead(d, meta)
+}
+
+func resourceNetboxCircuitTerminationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTerminationDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "circuits/circuit-terminations/")
+}
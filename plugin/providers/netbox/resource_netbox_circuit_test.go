@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxCircuitConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-circuit-dc"
+  slug = "terraform-test-circuit-dc"
+}
+
+resource "netbox_circuit_provider" "isp" {
+  name = "terraform-test-circuit-isp"
+  slug = "terraform-test-circuit-isp"
+}
+
+resource "netbox_provider_network" "mpls" {
+  name        = "terraform-test-circuit-mpls"
+  provider_id = "${netbox_circuit_provider.isp.id}"
+}
+
+resource "netbox_circuit_type" "transit" {
+  name = "terraform-test-circuit-transit"
+  slug = "terraform-test-circuit-transit"
+}
+
+resource "netbox_circuit" "c1" {
+  cid          = "terraform-test-circuit-c1"
+  provider_id  = "${netbox_circuit_provider.isp.id}"
+  type_id      = "${netbox_circuit_type.transit.id}"
+  commit_rate  = 100000
+  install_date = "2024-01-15"
+}
+
+resource "netbox_circuit_termination" "a" {
+  circuit_id  = "${netbox_circuit.c1.id}"
+  term_side   = "A"
+  site_id     = "${netbox_site.dc.id}"
+  port_speed  = 1000000
+  xconnect_id = "XC-1"
+}
+
+resource "netbox_circuit_termination" "z" {
+  circuit_id          = "${netbox_circuit.c1.id}"
+  term_side           = "Z"
+  provider_network_id = "${netbox_provider_network.mpls.id}"
+}
+
+data "netbox_circuit" "c1" {
+  cid         = "${netbox_circuit.c1.cid}"
+  provider_id = "${netbox_circuit_provider.isp.id}"
+}
+
+data "netbox_circuit_termination" "a" {
+  circuit_id = "${netbox_circuit_termination.a.circuit_id}"
+  term_side  = "A"
+}
+`
+
+func TestAccResourceNetboxCircuit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxCircuitConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit.c1", "status", "active"),
+					resource.TestCheckResourceAttrPair("data.netbox_circuit.c1", "id", "netbox_circuit.c1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_circuit.c1", "install_date", "2024-01-15"),
+					resource.TestCheckResourceAttrPair("data.netbox_circuit_termination.a", "site_id", "netbox_site.dc", "id"),
+					resource.TestCheckResourceAttr("data.netbox_circuit_termination.a", "xconnect_id", "XC-1"),
+					resource.TestCheckResourceAttrPair("netbox_circuit_termination.z", "provider_network_id", "netbox_provider_network.mpls", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestCircuitTerminationPoint(t *testing.T) {
+	old := map[string]interface{}{"site": map[string]interface{}{"id": 1.0}, "provider_network": nil}
+	if site, network := circuitTerminationPoint(old); site != 1 || network != 0 {
+		t.Errorf("circuitTerminationPoint(%v) = %d, %d", old, site, network)
+	}
+	current := map[string]interface{}{
+		"termination_type": "circuits.providernetwork",
+		"termination":      map[string]interface{}{"id": 2.0},
+	}
+	if site, network := circuitTerminationPoint(current); site != 0 || network != 2 {
+		t.Errorf("circuitTerminationPoint(%v) = %d, %d", current, site, network)
+	}
+}
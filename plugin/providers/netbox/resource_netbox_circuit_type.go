@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxCircuitType returns the resource structure for the
+// netbox_circuit_type resource.
+func resourceNetboxCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitTypeCreate,
+		Read:   resourceNetboxCircuitTypeRead,
+		Update: resourceNetboxCircuitTypeUpdate,
+		Delete: resourceNetboxCircuitTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceCircuitTypeSchema(),
+	}
+}
+
+// expandCircuitType builds the body of the circuit type create and update
+// requests.
+func expandCircuitType(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxCircuitTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTypeCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "circuits/circuit-types/", expandCircuitType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitTypeRead(d, meta)
+}
+
+func resourceNetboxCircuitTypeRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "circuits/circuit-types/", setCircuitTypeFields)
+}
+
+func resourceNetboxCircuitTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTypeUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "circuits/circuit-types/", expandCircuitType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxCircuitTypeRead(d, meta)
+}
+
+func resourceNetboxCircuitTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCircuitTypeDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "circuits/circuit-types/")
+}
@@ -0,0 +1,73 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxCluster returns the resource structure for the netbox_cluster
+// resource.
+func resourceNetboxCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxClusterCreate,
+		Read:   resourceNetboxClusterRead,
+		Update: resourceNetboxClusterUpdate,
+		Delete: resourceNetboxClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceClusterSchema(),
+	}
+}
+
+// expandCluster builds the body of the cluster create and update requests.
+func expandCluster(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"type":          optionalID(d, "type_id"),
+		"group":         optionalID(d, "group_id"),
+		"status":        d.Get("status").(string),
+		"tenant":        optionalID(d, "tenant_id"),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "4.2") {
+		data["scope_type"] = nil
+		data["scope_id"] = optionalID(d, "site_id")
+		if data["scope_id"] != nil {
+			data["scope_type"] = "dcim.site"
+		}
+	} else {
+		data["site"] = optionalID(d, "site_id")
+	}
+	return data
+}
+
+func resourceNetboxClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "virtualization/clusters/", expandCluster(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterRead(d, meta)
+}
+
+func resourceNetboxClusterRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "virtualization/clusters/", setClusterFields)
+}
+
+func resourceNetboxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "virtualization/clusters/", expandCluster(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterRead(d, meta)
+}
+
+func resourceNetboxClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "virtualization/clusters/")
+}
@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxClusterGroup returns the resource structure for the
+// netbox_cluster_group resource.
+func resourceNetboxClusterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxClusterGroupCreate,
+		Read:   resourceNetboxClusterGroupRead,
+		Update: resourceNetboxClusterGroupUpdate,
+		Delete: resourceNetboxClusterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceClusterGroupSchema(),
+	}
+}
+
+// expandClusterGroup builds the body of the cluster group create and update
+// requests.
+func expandClusterGroup(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxClusterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterGroupCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "virtualization/cluster-groups/", expandClusterGroup(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterGroupRead(d, meta)
+}
+
+func resourceNetboxClusterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "virtualization/cluster-groups/", setClusterGroupFields)
+}
+
+func resourceNetboxClusterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterGroupUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "virtualization/cluster-groups/", expandClusterGroup(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterGroupRead(d, meta)
+}
+
+func resourceNetboxClusterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterGroupDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "virtualization/cluster-groups/")
+}
@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxClusterType returns the resource structure for the
+// netbox_cluster_type resource.
+func resourceNetboxClusterType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxClusterTypeCreate,
+		Read:   resourceNetboxClusterTypeRead,
+		Update: resourceNetboxClusterTypeUpdate,
+		Delete: resourceNetboxClusterTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceClusterTypeSchema(),
+	}
+}
+
+// expandClusterType builds the body of the cluster type create and update
+// requests.
+func expandClusterType(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxClusterTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterTypeCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "virtualization/cluster-types/", expandClusterType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterTypeRead(d, meta)
+}
+
+func resourceNetboxClusterTypeRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "virtualization/cluster-types/", setClusterTypeFields)
+}
+
+func resourceNetboxClusterTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterTypeUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "virtualization/cluster-types/", expandClusterType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxClusterTypeRead(d, meta)
+}
+
+func resourceNetboxClusterTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxClusterTypeDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "virtualization/cluster-types/")
+}
@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxConfigContext returns the resource structure for the
+// netbox_config_context resource, data merged into the config context of the
+// devices and virtual machines of its scope.
+func resourceNetboxConfigContext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxConfigContextCreate,
+		Read:   resourceNetboxConfigContextRead,
+		Update: resourceNetboxConfigContextUpdate,
+		Delete: resourceNetboxConfigContextDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceConfigContextSchema(),
+	}
+}
+
+// expandConfigContext builds the body of the config context create and update
+// requests.
+func expandConfigContext(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"weight":         d.Get("weight").(int),
+		"description":    d.Get("description").(string),
+		"is_active":      d.Get("is_active").(bool),
+		"data":           expandJSON(d, "data"),
+		"regions":        expandIDSet(d, "region_ids"),
+		"sites":          expandIDSet(d, "site_ids"),
+		"roles":          expandIDSet(d, "role_ids"),
+		"platforms":      expandIDSet(d, "platform_ids"),
+		"cluster_groups": expandIDSet(d, "cluster_group_ids"),
+		"tenants":        expandIDSet(d, "tenant_ids"),
+		"tags":           expandStringList(d, "tags"),
+	}
+}
+
+func resourceNetboxConfigContextCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConfigContextCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "extras/config-contexts/", expandConfigContext(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxConfigContextRead(d, meta)
+}
+
+func resourceNetboxConfigContextRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/config-contexts/", setConfigContextFields)
+}
+
+func resourceNetboxConfigContextUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConfigContextUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/config-contexts/", expandConfigContext(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxConfigContextRead(d, meta)
+}
+
+func resourceNetboxConfigContextDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConfigContextDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/config-contexts/")
+}
+
+// setConfigContextFields sets the attributes of a netbox_config_context from
+// an API answer.
+func setConfigContextFields(d *schema.ResourceData, meta interface{}, context map[string]interface{}) {
+	d.Set("name", stringValue(context["name"]))
+	d.Set("weight", intValue(context["weight"]))
+	d.Set("description", stringValue(context["description"]))
+	d.Set("is_active", boolValue(context["is_active"]))
+	d.Set("data", flattenJSON(context["data"]))
+	d.Set("region_ids", flattenIDList(context["regions"]))
+	d.Set("site_ids", flattenIDList(context["sites"]))
+	d.Set("role_ids", flattenIDList(context["roles"]))
+	d.Set("platform_ids", flattenIDList(context["platforms"]))
+	d.Set("cluster_group_ids", flattenIDList(context["cluster_groups"]))
+	d.Set("tenant_ids", flattenIDList(context["tenants"]))
+	d.Set("tags", flattenStringList(context["tags"]))
+}
+
+func bareConfigContextSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Contexts of higher weight are merged last and win.
+		"weight": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"is_active": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// Data of the context, as a JSON document.
+		"data": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Scope of the context. An empty scope matches every object.
+		"region_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"site_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		// Device roles.
+		"role_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"platform_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"cluster_group_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"tenant_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		// Slugs of the tags of the scope.
+		"tags": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+			Set:  schema.HashString,
+		},
+	}
+}
+
+func resourceConfigContextSchema() map[string]*schema.Schema {
+	s := bareConfigContextSchema()
+	for k, v := range s {
+		switch k {
+		case "name":
+			v.Required = true
+		case "weight":
+			v.Optional = true
+			v.Default = 1000
+			v.ValidateFunc = validation.IntBetween(0, 32767)
+		case "description", "region_ids", "site_ids", "role_ids", "platform_ids", "cluster_group_ids", "tenant_ids", "tags":
+			v.Optional = true
+		case "is_active":
+			v.Optional = true
+			v.Default = true
+		case "data":
+			v.Required = true
+			v.ValidateFunc = validation.ValidateJsonString
+			v.DiffSuppressFunc = suppressEquivalentJSON
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
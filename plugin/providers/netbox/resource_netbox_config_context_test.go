@@ -0,0 +1,63 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxConfigContextConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-context-dc"
+  slug = "terraform-test-context-dc"
+}
+
+resource "netbox_cluster_type" "vmware" {
+  name = "terraform-test-context-vmware"
+  slug = "terraform-test-context-vmware"
+}
+
+resource "netbox_cluster" "c1" {
+  name    = "terraform-test-context-c1"
+  type_id = "${netbox_cluster_type.vmware.id}"
+  site_id = "${netbox_site.dc.id}"
+}
+
+resource "netbox_virtual_machine" "vm" {
+  name       = "terraform-test-context-vm"
+  cluster_id = "${netbox_cluster.c1.id}"
+}
+
+resource "netbox_config_context" "ntp" {
+  name     = "terraform-test-context-ntp"
+  weight   = 2000
+  site_ids = ["${netbox_site.dc.id}"]
+  data     = <<EOT
+{
+  "ntp_servers": ["10.0.0.1", "10.0.0.2"]
+}
+EOT
+}
+
+data "netbox_rendered_config_context" "vm" {
+  virtual_machine_id = "${netbox_virtual_machine.vm.id}"
+  depends_on         = ["netbox_config_context.ntp"]
+}
+`
+
+func TestAccResourceNetboxConfigContext(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxConfigContextConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_config_context.ntp", "is_active", "true"),
+					resource.TestCheckResourceAttr("netbox_config_context.ntp", "site_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_rendered_config_context.vm", "config_context", `{"ntp_servers":["10.0.0.1","10.0.0.2"]}`),
+				),
+			},
+		},
+	})
+}
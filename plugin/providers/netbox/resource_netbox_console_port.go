@@ -0,0 +1,146 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxConsolePort returns the resource structure for the
+// netbox_console_port resource, the console port of a device.
+func resourceNetboxConsolePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxConsolePortCreate,
+		Read:   resourceNetboxConsolePortRead,
+		Update: resourceNetboxConsolePortUpdate,
+		Delete: resourceNetboxConsolePortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceConsolePortSchema(),
+	}
+}
+
+// expandConsolePort builds the body of the console port create and update
+// requests.
+func expandConsolePort(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"device":         optionalID(d, "device_id"),
+		"name":           d.Get("name").(string),
+		"label":          d.Get("label").(string),
+		"type":           d.Get("type").(string),
+		"speed":          optionalInt(d, "speed"),
+		"description":    d.Get("description").(string),
+		"mark_connected": d.Get("mark_connected").(bool),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxConsolePortCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConsolePortCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/console-ports/", expandConsolePort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxConsolePortRead(d, meta)
+}
+
+func resourceNetboxConsolePortRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/console-ports/", setConsolePortFields)
+}
+
+func resourceNetboxConsolePortUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConsolePortUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/console-ports/", expandConsolePort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxConsolePortRead(d, meta)
+}
+
+func resourceNetboxConsolePortDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxConsolePortDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/console-ports/")
+}
+
+// setConsolePortFields sets the attributes of a netbox_console_port from an
+// API answer.
+func setConsolePortFields(d *schema.ResourceData, meta interface{}, port map[string]interface{}) {
+	d.Set("device_id", nestedID(port["device"]))
+	d.Set("name", stringValue(port["name"]))
+	d.Set("label", stringValue(port["label"]))
+	d.Set("type", choiceValue(port["type"]))
+	d.Set("speed", intValue(port["speed"]))
+	d.Set("description", stringValue(port["description"]))
+	d.Set("mark_connected", boolValue(port["mark_connected"]))
+	d.Set("tags", flattenTags(port["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, port["custom_fields"]))
+	setConnectionFields(d, port)
+}
+
+func bareConsolePortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Physical label, netbox 2.9+.
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the port, like "rj-45" or "de-9".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Speed in bps, netbox 3.0+.
+		"speed": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the component is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Endpoint at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourceConsolePortSchema() map[string]*schema.Schema {
+	s := bareConsolePortSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name":
+			v.Required = true
+		case "label", "type", "speed", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
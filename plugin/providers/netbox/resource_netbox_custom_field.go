@@ -0,0 +1,318 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxCustomField returns the resource structure for the
+// netbox_custom_field resource, that defines the custom_fields available on
+// the objects.
+func resourceNetboxCustomField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCustomFieldCreate,
+		Read:   resourceNetboxCustomFieldRead,
+		Update: resourceNetboxCustomFieldUpdate,
+		Delete: resourceNetboxCustomFieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceCustomFieldSchema(),
+	}
+}
+
+// expandCustomField builds the body of the custom field create and update
+// requests.
+func expandCustomField(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"name":             d.Get("name").(string),
+		"label":            d.Get("label").(string),
+		"description":      d.Get("description").(string),
+		"type":             d.Get("type").(string),
+		"required":         d.Get("required").(bool),
+		"validation_regex": d.Get("validation_regex").(string),
+		"weight":           d.Get("weight").(int),
+		"filter_logic":     d.Get("filter_logic").(string),
+	}
+	if netboxVersionAtLeast(meta, "4.0") {
+		data["object_types"] = expandStringList(d, "content_types")
+	} else {
+		data["content_types"] = expandStringList(d, "content_types")
+	}
+	data["default"] = nil
+	if v, ok := d.GetOk("default"); ok {
+		value, err := expandCustomFieldValue(d.Get("type").(string), v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("default: %v", err)
+		}
+		data["default"] = value
+	}
+	// GetOk can not tell an explicit 0 from an unset bound. The state only
+	// holds the bounds of the configuration, see setCustomFieldFields.
+	data["validation_minimum"] = nil
+	if v, ok := d.GetOkExists("validation_minimum"); ok {
+		data["validation_minimum"] = v.(int)
+	}
+	data["validation_maximum"] = nil
+	if v, ok := d.GetOkExists("validation_maximum"); ok {
+		data["validation_maximum"] = v.(int)
+	}
+	if !netboxVersionAtLeast(meta, "3.6") {
+		data["choices"] = expandStringList(d, "choices")
+	}
+	return data, nil
+}
+
+// customFieldChoiceSetPath is the path of the choice sets, that hold the
+// choices of the custom fields since netbox 3.6.
+const customFieldChoiceSetPath = "extras/custom-field-choice-sets/"
+
+// saveCustomFieldChoiceSet creates or updates the choice set of the custom
+// field with its choices, on netbox 3.6+, and adds it to data. A choice set
+// no longer used is deleted by removeCustomFieldChoiceSet, once the field does
+// not reference it anymore.
+func saveCustomFieldChoiceSet(d *schema.ResourceData, meta interface{}, data map[string]interface{}) error {
+	if !netboxVersionAtLeast(meta, "3.6") {
+		return nil
+	}
+	choices := expandStringList(d, "choices")
+	if len(choices) == 0 {
+		data["choice_set"] = nil
+		return nil
+	}
+	extraChoices := [][]string{}
+	for _, c := range choices {
+		extraChoices = append(extraChoices, []string{c, c})
+	}
+	set := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"extra_choices": extraChoices,
+	}
+	if id := d.Get("choice_set_id").(int); id != 0 {
+		data["choice_set"] = id
+		return netboxAPIRequest(meta, "PATCH", customFieldChoiceSetPath+strconv.Itoa(id)+"/", set, nil)
+	}
+	var out map[string]interface{}
+	if err := netboxAPIRequest(meta, "POST", customFieldChoiceSetPath, set, &out); err != nil {
+		return err
+	}
+	data["choice_set"] = nestedID(out["id"])
+	d.Set("choice_set_id", nestedID(out["id"]))
+	return nil
+}
+
+// removeCustomFieldChoiceSet deletes the choice set of the custom field, once
+// the field was deleted or has no choices anymore.
+func removeCustomFieldChoiceSet(d *schema.ResourceData, meta interface{}) error {
+	id := d.Get("choice_set_id").(int)
+	if id == 0 {
+		return nil
+	}
+	err := netboxAPIRequest(meta, "DELETE", customFieldChoiceSetPath+strconv.Itoa(id)+"/", nil, nil)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	d.Set("choice_set_id", 0)
+	return nil
+}
+
+// readCustomFieldChoiceSet sets the choices of the custom field from its
+// choice set, on netbox 3.6+.
+func readCustomFieldChoiceSet(d *schema.ResourceData, meta interface{}) error {
+	id := d.Get("choice_set_id").(int)
+	if id == 0 {
+		if netboxVersionAtLeast(meta, "3.6") {
+			d.Set("choices", []string{})
+		}
+		return nil
+	}
+	var set map[string]interface{}
+	if err := netboxAPIRequest(meta, "GET", customFieldChoiceSetPath+strconv.Itoa(id)+"/", nil, &set); err != nil {
+		return err
+	}
+	choices := []string{}
+	if l, ok := set["extra_choices"].([]interface{}); ok {
+		for _, c := range l {
+			if pair, ok := c.([]interface{}); ok && len(pair) > 0 {
+				choices = append(choices, stringValue(pair[0]))
+			}
+		}
+	}
+	d.Set("choices", choices)
+	return nil
+}
+
+func resourceNetboxCustomFieldCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCustomFieldCreate: %v\n", d.Get("name"))
+	data, err := expandCustomField(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := saveCustomFieldChoiceSet(d, meta, data); err != nil {
+		return err
+	}
+	if err := netboxObjectCreate(d, meta, "extras/custom-fields/", data); err != nil {
+		return err
+	}
+	resetCustomFieldTypes(meta)
+	return resourceNetboxCustomFieldRead(d, meta)
+}
+
+func resourceNetboxCustomFieldRead(d *schema.ResourceData, meta interface{}) error {
+	if err := netboxObjectRead(d, meta, "extras/custom-fields/", setCustomFieldFields); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return readCustomFieldChoiceSet(d, meta)
+}
+
+func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCustomFieldUpdate: %v\n", d.Id())
+	data, err := expandCustomField(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := saveCustomFieldChoiceSet(d, meta, data); err != nil {
+		return err
+	}
+	if err := netboxObjectUpdate(d, meta, "extras/custom-fields/", data); err != nil {
+		return err
+	}
+	resetCustomFieldTypes(meta)
+	if len(expandStringList(d, "choices")) == 0 {
+		if err := removeCustomFieldChoiceSet(d, meta); err != nil {
+			return err
+		}
+	}
+	return resourceNetboxCustomFieldRead(d, meta)
+}
+
+func resourceNetboxCustomFieldDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxCustomFieldDelete: %v\n", d.Id())
+	if err := netboxObjectDelete(d, meta, "extras/custom-fields/"); err != nil {
+		return err
+	}
+	resetCustomFieldTypes(meta)
+	return removeCustomFieldChoiceSet(d, meta)
+}
+
+// setCustomFieldFields sets the attributes of a netbox_custom_field from an
+// API answer.
+func setCustomFieldFields(d *schema.ResourceData, meta interface{}, field map[string]interface{}) {
+	d.Set("name", stringValue(field["name"]))
+	d.Set("label", stringValue(field["label"]))
+	d.Set("description", stringValue(field["description"]))
+	d.Set("type", choiceValue(field["type"]))
+	types := field["content_types"]
+	if v, ok := field["object_types"]; ok {
+		types = v
+	}
+	d.Set("content_types", flattenStringList(types))
+	d.Set("required", boolValue(field["required"]))
+	d.Set("default", flattenCustomFieldValue(choiceValue(field["type"]), field["default"]))
+	d.Set("validation_regex", stringValue(field["validation_regex"]))
+	// An unset bound is null, not 0, and is left out of the state so that
+	// the next update does not send it as 0.
+	for _, k := range []string{"validation_minimum", "validation_maximum"} {
+		if field[k] != nil {
+			d.Set(k, intValue(field[k]))
+		}
+	}
+	if choices, ok := field["choices"]; ok {
+		d.Set("choices", flattenStringList(choices))
+	}
+	d.Set("choice_set_id", nestedID(field["choice_set"]))
+	d.Set("weight", intValue(field["weight"]))
+	d.Set("filter_logic", choiceValue(field["filter_logic"]))
+}
+
+func bareCustomFieldSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Models of the field, like "ipam.vlan". Sent as object_types on
+		// netbox 4.0+.
+		"content_types": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+			Set:  schema.HashString,
+		},
+		"required": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// Default value, converted to the type of the field.
+		"default": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"validation_regex": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"validation_minimum": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"validation_maximum": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Choices of the select and multiselect fields. On netbox 3.6+ they
+		// are kept in a choice set managed with the field.
+		"choices": &schema.Schema{
+			Type: schema.TypeList,
+			Elem: &schema.Schema{Type: schema.TypeString},
+		},
+		"choice_set_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"weight": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"filter_logic": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceCustomFieldSchema() map[string]*schema.Schema {
+	s := bareCustomFieldSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "content_types":
+			v.Required = true
+		case "label", "description", "default", "validation_regex", "validation_minimum", "validation_maximum", "choices":
+			v.Optional = true
+		case "type":
+			v.Required = true
+			v.ForceNew = true
+			v.ValidateFunc = validation.StringInSlice(customFieldTypes, false)
+		case "required":
+			v.Optional = true
+			v.Default = false
+		case "weight":
+			v.Optional = true
+			v.Default = 100
+		case "filter_logic":
+			v.Optional = true
+			v.Default = "loose"
+			v.ValidateFunc = validation.StringInSlice([]string{"disabled", "loose", "exact"}, false)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
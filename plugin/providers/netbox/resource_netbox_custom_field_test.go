@@ -0,0 +1,179 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxCustomFieldConfig = `
+resource "netbox_custom_field" "owner" {
+  name          = "terraform_test_owner"
+  type          = "text"
+  content_types = ["ipam.vlan", "ipam.ipaddress"]
+}
+
+resource "netbox_custom_field" "environment" {
+  name          = "terraform_test_environment"
+  type          = "select"
+  content_types = ["ipam.vlan"]
+  choices       = ["dev", "prod"]
+  default       = "dev"
+}
+
+resource "netbox_vlans" "custom" {
+  vid  = 3904
+  name = "terraform-test-custom"
+
+  custom_fields = {
+    "${netbox_custom_field.owner.name}" = "network"
+  }
+}
+`
+
+func TestAccResourceNetboxCustomField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxCustomFieldConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_custom_field.owner", "content_types.#", "2"),
+					resource.TestCheckResourceAttr("netbox_custom_field.environment", "choices.#", "2"),
+					resource.TestCheckResourceAttr("netbox_vlans.custom", "custom_fields.terraform_test_owner", "network"),
+				),
+			},
+		},
+	})
+}
+
+func TestCustomFieldValue(t *testing.T) {
+	cases := []struct {
+		fieldType string
+		value     string
+		api       interface{}
+	}{
+		{"text", "network", "network"},
+		{"integer", "42", 42},
+		{"decimal", "1.5", 1.5},
+		{"boolean", "true", true},
+		{"json", `{"a":1}`, map[string]interface{}{"a": 1.0}},
+		{"multiselect", "dev,prod", []string{"dev", "prod"}},
+		{"multiobject", "1,2", []int{1, 2}},
+	}
+	for _, c := range cases {
+		got, err := expandCustomFieldValue(c.fieldType, c.value)
+		if err != nil {
+			t.Fatalf("expandCustomFieldValue(%q, %q): %v", c.fieldType, c.value, err)
+		}
+		if !reflect.DeepEqual(got, c.api) {
+			t.Errorf("expandCustomFieldValue(%q, %q) = %#v, want %#v", c.fieldType, c.value, got, c.api)
+		}
+	}
+	if _, err := expandCustomFieldValue("integer", "many"); err == nil {
+		t.Error("expandCustomFieldValue of a bad integer should fail")
+	}
+	if _, err := expandCustomFieldValue("date", "01/02/2018"); err == nil {
+		t.Error("expandCustomFieldValue of a bad date should fail")
+	}
+
+	answers := []struct {
+		fieldType string
+		api       interface{}
+		want      string
+	}{
+		{"integer", 42.0, "42"},
+		{"boolean", false, "false"},
+		{"json", map[string]interface{}{"a": 1.0}, `{"a":1}`},
+		{"multiselect", []interface{}{"dev", "prod"}, "dev,prod"},
+		{"multiobject", []interface{}{map[string]interface{}{"id": 1.0}, map[string]interface{}{"id": 2.0}}, "1,2"},
+		{"object", map[string]interface{}{"id": 3.0}, "3"},
+		{"text", nil, ""},
+	}
+	for _, c := range answers {
+		if got := flattenCustomFieldValue(c.fieldType, c.api); got != c.want {
+			t.Errorf("flattenCustomFieldValue(%q, %v) = %q, want %q", c.fieldType, c.api, got, c.want)
+		}
+	}
+}
+
+func TestFlattenCustomFields(t *testing.T) {
+	// Netbox before 2.10 has no definitions, the types are guessed.
+	meta := &ProviderNetboxClient{apiVersion: "2.9"}
+	got := flattenCustomFieldsKeeping(meta, map[string]interface{}{
+		"owner":   "network",
+		"cost":    12.0,
+		"managed": true,
+		"ticket":  nil,
+		"tenant":  map[string]interface{}{"id": 4.0, "name": "ACME"},
+		"envs":    []interface{}{"dev", "prod"},
+		"extra":   map[string]interface{}{"a": []interface{}{1.0}},
+	}, nil)
+	want := map[string]interface{}{
+		"owner":   "network",
+		"cost":    "12",
+		"managed": "true",
+		"tenant":  "4",
+		"envs":    "dev,prod",
+		"extra":   `{"a":[1]}`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenCustomFields = %v, want %v", got, want)
+	}
+
+	meta = &ProviderNetboxClient{
+		apiVersion: "4.0",
+		customFieldTypes: map[string]string{
+			"envs":   "json",
+			"extra":  "json",
+			"tenant": "object",
+			"cost":   "decimal",
+			"owner":  "text",
+		},
+	}
+	// The equivalent values of the state are kept, not the text ones.
+	current := map[string]interface{}{"cost": "12.0", "owner": "1.0"}
+	got = flattenCustomFieldsKeeping(meta, map[string]interface{}{
+		"envs":   []interface{}{"dev", "prod"},
+		"extra":  map[string]interface{}{"id": 4.0},
+		"tenant": map[string]interface{}{"id": 4.0, "name": "ACME"},
+		"cost":   12.0,
+		"owner":  "1",
+	}, current)
+	want = map[string]interface{}{
+		"envs":   `["dev","prod"]`,
+		"extra":  `{"id":4}`,
+		"tenant": "4",
+		"cost":   "12.0",
+		"owner":  "1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenCustomFields = %v, want %v", got, want)
+	}
+}
+
+func TestEquivalentCustomFieldValue(t *testing.T) {
+	cases := []struct {
+		fieldType string
+		value     string
+		api       interface{}
+		want      bool
+	}{
+		{"decimal", "1.0", 1.0, true},
+		{"integer", "1", 2.0, false},
+		{"boolean", "True", true, true},
+		{"json", `{"a": 1, "b": 2}`, map[string]interface{}{"b": 2.0, "a": 1.0}, true},
+		{"multiselect", "dev, prod", []interface{}{"dev", "prod"}, true},
+		{"multiselect", "prod,dev", []interface{}{"dev", "prod"}, false},
+		{"text", "1.0", "1", false},
+		{"text", "t", "true", false},
+		{"select", "b,a", "a,b", false},
+	}
+	for _, c := range cases {
+		if got := equivalentCustomFieldValue(c.fieldType, c.value, c.api); got != c.want {
+			t.Errorf("equivalentCustomFieldValue(%q, %q, %v) = %v, want %v", c.fieldType, c.value, c.api, got, c.want)
+		}
+	}
+}
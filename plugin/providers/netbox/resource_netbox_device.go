@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxDevice returns the resource structure for the netbox_device
+// resource.
+func resourceNetboxDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceCreate,
+		Read:   resourceNetboxDeviceRead,
+		Update: resourceNetboxDeviceUpdate,
+		Delete: resourceNetboxDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxDeviceCustomizeDiff,
+
+		Schema: resourceDeviceSchema(),
+	}
+}
+
+// resourceNetboxDeviceCustomizeDiff checks at plan time the custom fields and
+// that a device with a position in a rack also has the rack and the face.
+func resourceNetboxDeviceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomFields(d, meta); err != nil {
+		return err
+	}
+	if _, ok := d.GetOk("position"); !ok {
+		return nil
+	}
+	if _, ok := d.GetOk("rack_id"); !ok && d.NewValueKnown("rack_id") {
+		return errors.New("position requires rack_id")
+	}
+	if _, ok := d.GetOk("face"); !ok && d.NewValueKnown("face") {
+		return errors.New("position requires face")
+	}
+	return nil
+}
+
+// expandDevice builds the body of the device create and update requests.
+func expandDevice(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          optionalString(d, "name"),
+		"device_type":   optionalID(d, "device_type_id"),
+		"site":          optionalID(d, "site_id"),
+		"rack":          optionalID(d, "rack_id"),
+		"position":      optionalFloat(d, "position"),
+		"face":          optionalChoice(d, meta, "face", deviceFaceLegacy),
+		"status":        expandChoice(meta, d.Get("status").(string), deviceStatusLegacy),
+		"platform":      optionalID(d, "platform_id"),
+		"tenant":        optionalID(d, "tenant_id"),
+		"serial":        d.Get("serial").(string),
+		"asset_tag":     optionalString(d, "asset_tag"),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "3.6") {
+		data["role"] = d.Get("role_id").(int)
+	} else {
+		data["device_role"] = d.Get("role_id").(int)
+	}
+	for _, key := range []string{"primary_ip4_id", "primary_ip6_id"} {
+		if v, ok := d.GetOk(key); ok {
+			data[strings.TrimSuffix(key, "_id")] = v.(int)
+		}
+	}
+	return data
+}
+
+func resourceNetboxDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/devices/", expandDevice(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceRead(d, meta)
+}
+
+func resourceNetboxDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/devices/", setDeviceFields)
+}
+
+func resourceNetboxDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/devices/", expandDevice(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceRead(d, meta)
+}
+
+func resourceNetboxDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/devices/")
+}
@@ -0,0 +1,240 @@
+package netbox
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxDeviceInterface returns the resource structure for the
+// netbox_device_interface resource.
+func resourceNetboxDeviceInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceInterfaceCreate,
+		Read:   resourceNetboxDeviceInterfaceRead,
+		Update: resourceNetboxDeviceInterfaceUpdate,
+		Delete: resourceNetboxDeviceInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceDeviceInterfaceSchema(),
+	}
+}
+
+// expandDeviceInterface builds the body of the device interface create and
+// update requests.
+func expandDeviceInterface(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":        optionalID(d, "device_id"),
+		"name":          d.Get("name").(string),
+		"type":          d.Get("type").(string),
+		"lag":           optionalID(d, "lag_id"),
+		"mgmt_only":     d.Get("mgmt_only").(bool),
+		"enabled":       d.Get("enabled").(bool),
+		"mtu":           optionalInt(d, "mtu"),
+		"mac_address":   optionalString(d, "mac_address"),
+		"mode":          optionalChoice(d, meta, "mode", interfaceModeLegacy),
+		"untagged_vlan": optionalID(d, "untagged_vlan_id"),
+		"tagged_vlans":  expandIDSet(d, "tagged_vlan_ids"),
+		"parent":        optionalID(d, "parent_id"),
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	// The MAC address of the interface is read only on netbox 4.2+, see
+	// setInterfaceMACAddress.
+	if netboxVersionAtLeast(meta, "4.2") {
+		delete(data, "mac_address")
+	}
+	return data
+}
+
+func resourceNetboxDeviceInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceInterfaceCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/interfaces/", expandDeviceInterface(d, meta)); err != nil {
+		return err
+	}
+	if err := setInterfaceMACAddress(d, meta, "dcim/interfaces/"); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceInterfaceRead(d, meta)
+}
+
+func resourceNetboxDeviceInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/interfaces/", setDeviceInterfaceFields)
+}
+
+func resourceNetboxDeviceInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceInterfaceUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/interfaces/", expandDeviceInterface(d, meta)); err != nil {
+		return err
+	}
+	if err := setInterfaceMACAddress(d, meta, "dcim/interfaces/"); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceInterfaceRead(d, meta)
+}
+
+func resourceNetboxDeviceInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceInterfaceDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/interfaces/")
+}
+
+// setInterfaceMACAddress sets the mac_address of the device or VM interface
+// of the resource on netbox 4.2+, where the MAC addresses are
+// dcim/mac-addresses/ objects and the interface only points to its primary
+// one. The primary address is changed in place, created and assigned to the
+// interface, or unset and deleted when mac_address is removed.
+func setInterfaceMACAddress(d *schema.ResourceData, meta interface{}, path string) error {
+	if !netboxVersionAtLeast(meta, "4.2") || !d.HasChange("mac_address") {
+		return nil
+	}
+	var iface map[string]interface{}
+	if err := netboxAPIRequest(meta, "GET", path+d.Id()+"/", nil, &iface); err != nil {
+		return err
+	}
+	mac := d.Get("mac_address").(string)
+	primary := nestedID(iface["primary_mac_address"])
+	if primary != 0 {
+		macPath := "dcim/mac-addresses/" + strconv.Itoa(primary) + "/"
+		if mac != "" {
+			return netboxAPIRequest(meta, "PATCH", macPath, map[string]interface{}{"mac_address": mac}, nil)
+		}
+		// Netbox refuses to unassign the primary address of an interface.
+		data := map[string]interface{}{"primary_mac_address": nil}
+		if err := netboxAPIRequest(meta, "PATCH", path+d.Id()+"/", data, nil); err != nil {
+			return err
+		}
+		return netboxAPIRequest(meta, "DELETE", macPath, nil, nil)
+	}
+	if mac == "" {
+		return nil
+	}
+	id, _ := strconv.Atoi(d.Id())
+	data := map[string]interface{}{
+		"mac_address":          mac,
+		"assigned_object_type": journalObjectType(path),
+		"assigned_object_id":   id,
+	}
+	var out map[string]interface{}
+	if err := netboxAPIRequest(meta, "POST", "dcim/mac-addresses/", data, &out); err != nil {
+		return err
+	}
+	data = map[string]interface{}{"primary_mac_address": nestedID(out["id"])}
+	return netboxAPIRequest(meta, "PATCH", path+d.Id()+"/", data, nil)
+}
+
+// interfaceModeLegacy maps the 802.1Q modes to the values used before netbox
+// 2.7.
+var interfaceModeLegacy = map[string]int{
+	"access":     100,
+	"tagged":     200,
+	"tagged-all": 300,
+}
+
+// setDeviceInterfaceFields sets the attributes of a netbox_device_interface
+// from an API answer.
+func setDeviceInterfaceFields(d *schema.ResourceData, meta interface{}, iface map[string]interface{}) {
+	d.Set("device_id", nestedID(iface["device"]))
+	d.Set("name", stringValue(iface["name"]))
+	d.Set("type", choiceValue(iface["type"]))
+	d.Set("lag_id", nestedID(iface["lag"]))
+	d.Set("mgmt_only", boolValue(iface["mgmt_only"]))
+	d.Set("enabled", boolValue(iface["enabled"]))
+	d.Set("mtu", intValue(iface["mtu"]))
+	d.Set("mac_address", stringValue(iface["mac_address"]))
+	d.Set("mode", flattenChoice(iface["mode"], interfaceModeLegacy))
+	d.Set("untagged_vlan_id", nestedID(iface["untagged_vlan"]))
+	d.Set("tagged_vlan_ids", flattenIDList(iface["tagged_vlans"]))
+	d.Set("parent_id", nestedID(iface["parent"]))
+	d.Set("description", stringValue(iface["description"]))
+	d.Set("tags", flattenTags(iface["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, iface["custom_fields"]))
+}
+
+func bareDeviceInterfaceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the interface, like "1000base-t" or "virtual", netbox 2.7+.
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// LAG interface the interface is a member of.
+		"lag_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"mgmt_only": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"enabled": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"mtu": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// MAC address. On netbox 4.2+ it is the primary MAC address object of
+		// the interface.
+		"mac_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// 802.1Q mode, needed by the VLANs.
+		"mode": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"untagged_vlan_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tagged_vlan_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		// Parent interface, netbox 3.0+.
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceDeviceInterfaceSchema() map[string]*schema.Schema {
+	s := bareDeviceInterfaceSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name", "type":
+			v.Required = true
+		case "lag_id", "mac_address", "untagged_vlan_id", "tagged_vlan_ids", "parent_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "mgmt_only":
+			v.Optional = true
+			v.Default = false
+		case "enabled":
+			v.Optional = true
+			v.Default = true
+		case "mtu":
+			v.Optional = true
+			v.ValidateFunc = validation.IntBetween(1, 65536)
+		case "mode":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"access", "tagged", "tagged-all"}, false)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
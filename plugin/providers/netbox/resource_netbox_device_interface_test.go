@@ -0,0 +1,81 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxDeviceInterfaceConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-iface-dc"
+  slug = "terraform-test-iface-dc"
+}
+
+resource "netbox_manufacturer" "acme" {
+  name = "terraform-test-iface-acme"
+  slug = "terraform-test-iface-acme"
+}
+
+resource "netbox_device_type" "server" {
+  manufacturer_id = "${netbox_manufacturer.acme.id}"
+  model           = "terraform-test-server"
+  slug            = "terraform-test-server"
+}
+
+resource "netbox_device_role" "server" {
+  name  = "terraform-test-server"
+  slug  = "terraform-test-server"
+  color = "9e9e9e"
+}
+
+resource "netbox_device" "srv1" {
+  name           = "terraform-test-srv1"
+  device_type_id = "${netbox_device_type.server.id}"
+  role_id        = "${netbox_device_role.server.id}"
+  site_id        = "${netbox_site.dc.id}"
+}
+
+resource "netbox_vlans" "access" {
+  vid  = 3905
+  name = "terraform-test-access"
+}
+
+resource "netbox_device_interface" "eth0" {
+  device_id        = "${netbox_device.srv1.id}"
+  name             = "eth0"
+  type             = "1000base-t"
+  mtu              = 1500
+  mode             = "access"
+  untagged_vlan_id = "${netbox_vlans.access.id}"
+}
+
+resource "netbox_prefixes" "servers" {
+  prefix = "10.105.0.0/24"
+}
+
+resource "netbox_prefixes_available_ips" "srv1" {
+  prefixes_id          = "${netbox_prefixes.servers.id}"
+  description          = "terraform-test-srv1"
+  assigned_object_type = "dcim.interface"
+  assigned_object_id   = "${netbox_device_interface.eth0.id}"
+}
+`
+
+func TestAccResourceNetboxDeviceInterface(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxDeviceInterfaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.eth0", "mode", "access"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.eth0", "untagged_vlan_id", "netbox_vlans.access", "id"),
+					resource.TestCheckResourceAttrPair("netbox_prefixes_available_ips.srv1", "assigned_object_id", "netbox_device_interface.eth0", "id"),
+					resource.TestCheckResourceAttr("netbox_prefixes_available_ips.srv1", "interface_label", "eth0"),
+				),
+			},
+		},
+	})
+}
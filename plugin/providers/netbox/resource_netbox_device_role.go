@@ -0,0 +1,61 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxDeviceRole returns the resource structure for the
+// netbox_device_role resource.
+func resourceNetboxDeviceRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceRoleCreate,
+		Read:   resourceNetboxDeviceRoleRead,
+		Update: resourceNetboxDeviceRoleUpdate,
+		Delete: resourceNetboxDeviceRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceDeviceRoleSchema(),
+	}
+}
+
+// expandDeviceRole builds the body of the device role create and update
+// requests.
+func expandDeviceRole(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"color":       d.Get("color").(string),
+		"vm_role":     d.Get("vm_role").(bool),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceRoleCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/device-roles/", expandDeviceRole(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceRoleRead(d, meta)
+}
+
+func resourceNetboxDeviceRoleRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/device-roles/", setDeviceRoleFields)
+}
+
+func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceRoleUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/device-roles/", expandDeviceRole(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceRoleRead(d, meta)
+}
+
+func resourceNetboxDeviceRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceRoleDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/device-roles/")
+}
@@ -0,0 +1,81 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxDeviceConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-dc"
+  slug = "terraform-test-dc"
+}
+
+resource "netbox_manufacturer" "acme" {
+  name = "terraform-test-acme"
+  slug = "terraform-test-acme"
+}
+
+resource "netbox_device_type" "switch" {
+  manufacturer_id = "${netbox_manufacturer.acme.id}"
+  model           = "terraform-test-switch"
+  slug            = "terraform-test-switch"
+  u_height        = 1
+}
+
+resource "netbox_device_role" "access" {
+  name  = "terraform-test-access"
+  slug  = "terraform-test-access"
+  color = "2196f3"
+}
+
+resource "netbox_platform" "os" {
+  name = "terraform-test-os"
+  slug = "terraform-test-os"
+}
+
+resource "netbox_device" "sw1" {
+  name           = "terraform-test-sw1"
+  device_type_id = "${netbox_device_type.switch.id}"
+  role_id        = "${netbox_device_role.access.id}"
+  site_id        = "${netbox_site.dc.id}"
+  platform_id    = "${netbox_platform.os.id}"
+  serial         = "TF0001"
+  status         = "planned"
+}
+
+data "netbox_device" "sw1" {
+  name    = "${netbox_device.sw1.name}"
+  site_id = "${netbox_site.dc.id}"
+}
+`
+
+func TestAccResourceNetboxDevice(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxDeviceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device.sw1", "status", "planned"),
+					resource.TestCheckResourceAttrPair("netbox_device.sw1", "role_id", "netbox_device_role.access", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_device.sw1", "device_type_id", "netbox_device_type.switch", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device.sw1", "serial", "TF0001"),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceRoleID(t *testing.T) {
+	old := map[string]interface{}{"device_role": map[string]interface{}{"id": 3.0}}
+	if id := deviceRoleID(old); id != 3 {
+		t.Errorf("deviceRoleID(%v) = %d, want 3", old, id)
+	}
+	current := map[string]interface{}{"role": map[string]interface{}{"id": 4.0}}
+	if id := deviceRoleID(current); id != 4 {
+		t.Errorf("deviceRoleID(%v) = %d, want 4", current, id)
+	}
+}
@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxDeviceType returns the resource structure for the
+// netbox_device_type resource.
+func resourceNetboxDeviceType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeCreate,
+		Read:   resourceNetboxDeviceTypeRead,
+		Update: resourceNetboxDeviceTypeUpdate,
+		Delete: resourceNetboxDeviceTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceDeviceTypeSchema(),
+	}
+}
+
+// expandDeviceType builds the body of the device type create and update
+// requests.
+func expandDeviceType(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"manufacturer":   optionalID(d, "manufacturer_id"),
+		"model":          d.Get("model").(string),
+		"slug":           d.Get("slug").(string),
+		"part_number":    d.Get("part_number").(string),
+		"u_height":       d.Get("u_height").(float64),
+		"is_full_depth":  d.Get("is_full_depth").(bool),
+		"subdevice_role": d.Get("subdevice_role").(string),
+		"comments":       d.Get("comments").(string),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceTypeCreate: %v\n", d.Get("model"))
+	if err := netboxObjectCreate(d, meta, "dcim/device-types/", expandDeviceType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceTypeRead(d, meta)
+}
+
+func resourceNetboxDeviceTypeRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/device-types/", setDeviceTypeFields)
+}
+
+func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceTypeUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/device-types/", expandDeviceType(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxDeviceTypeRead(d, meta)
+}
+
+func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxDeviceTypeDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/device-types/")
+}
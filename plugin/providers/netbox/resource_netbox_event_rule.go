@@ -0,0 +1,178 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxEventRule returns the resource structure for the
+// netbox_event_rule resource, the events that trigger a webhook or a script,
+// netbox 3.7+.
+func resourceNetboxEventRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxEventRuleCreate,
+		Read:   resourceNetboxEventRuleRead,
+		Update: resourceNetboxEventRuleUpdate,
+		Delete: resourceNetboxEventRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxEventRuleCustomizeDiff,
+
+		Schema: resourceEventRuleSchema(),
+	}
+}
+
+// resourceNetboxEventRuleCustomizeDiff checks at plan time the custom fields
+// and that netbox has event rules.
+func resourceNetboxEventRuleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !netboxVersionAtLeast(meta, "3.7") {
+		return errors.New("netbox_event_rule requires netbox 3.7+, use the content_types and events of netbox_webhook")
+	}
+	return validateCustomFields(d, meta)
+}
+
+// expandEventRule builds the body of the event rule create and update
+// requests.
+func expandEventRule(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":               d.Get("name").(string),
+		"enabled":            d.Get("enabled").(bool),
+		"conditions":         expandJSON(d, "conditions"),
+		"action_type":        d.Get("action_type").(string),
+		"action_object_type": d.Get("action_object_type").(string),
+		"action_object_id":   d.Get("action_object_id").(int),
+		"action_data":        expandJSON(d, "action_data"),
+		"description":        d.Get("description").(string),
+		"tags":               expandTags(d, meta),
+		"custom_fields":      expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "4.0") {
+		data["object_types"] = expandStringList(d, "content_types")
+	} else {
+		data["content_types"] = expandStringList(d, "content_types")
+	}
+	expandEvents(d, meta, data)
+	return data
+}
+
+func resourceNetboxEventRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxEventRuleCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "extras/event-rules/", expandEventRule(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxEventRuleRead(d, meta)
+}
+
+func resourceNetboxEventRuleRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/event-rules/", setEventRuleFields)
+}
+
+func resourceNetboxEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxEventRuleUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/event-rules/", expandEventRule(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxEventRuleRead(d, meta)
+}
+
+func resourceNetboxEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxEventRuleDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/event-rules/")
+}
+
+// setEventRuleFields sets the attributes of a netbox_event_rule from an API
+// answer.
+func setEventRuleFields(d *schema.ResourceData, meta interface{}, rule map[string]interface{}) {
+	d.Set("name", stringValue(rule["name"]))
+	types := rule["content_types"]
+	if v, ok := rule["object_types"]; ok {
+		types = v
+	}
+	d.Set("content_types", flattenStringList(types))
+	d.Set("events", flattenEvents(rule))
+	d.Set("enabled", boolValue(rule["enabled"]))
+	d.Set("conditions", flattenJSON(rule["conditions"]))
+	d.Set("action_type", stringValue(rule["action_type"]))
+	d.Set("action_object_type", stringValue(rule["action_object_type"]))
+	d.Set("action_object_id", intValue(rule["action_object_id"]))
+	d.Set("action_data", flattenJSON(rule["action_data"]))
+	d.Set("description", stringValue(rule["description"]))
+	d.Set("tags", flattenTags(rule["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, rule["custom_fields"]))
+}
+
+func bareEventRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Models of the rule, like "ipam.prefix". Sent as object_types on
+		// netbox 4.0+.
+		"content_types": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+			Set:  schema.HashString,
+		},
+		"events": eventsSchema(),
+		"enabled": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// Conditions on the data of the objects, as a JSON document.
+		"conditions": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"action_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the object of the action, like "extras.webhook" or
+		// "extras.script".
+		"action_object_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"action_object_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Data passed to the action, as a JSON document.
+		"action_data": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceEventRuleSchema() map[string]*schema.Schema {
+	s := bareEventRuleSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "content_types", "events", "action_object_id":
+			v.Required = true
+		case "enabled":
+			v.Optional = true
+			v.Default = true
+		case "conditions", "action_data":
+			v.Optional = true
+			v.ValidateFunc = validation.ValidateJsonString
+			v.DiffSuppressFunc = suppressEquivalentJSON
+		case "action_type":
+			v.Optional = true
+			v.Default = "webhook"
+			v.ValidateFunc = validation.StringInSlice([]string{"webhook", "script", "notification"}, false)
+		case "action_object_type":
+			v.Optional = true
+			v.Default = "extras.webhook"
+		case "description", "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
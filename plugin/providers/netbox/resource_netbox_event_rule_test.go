@@ -0,0 +1,54 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxEventRuleConfig = `
+resource "netbox_webhook" "ci" {
+  name        = "terraform-test-event-ci"
+  payload_url = "https://ci.example.com/hooks/netbox"
+  secret      = "terraform-test"
+}
+
+resource "netbox_event_rule" "prefixes" {
+  name             = "terraform-test-event-prefixes"
+  content_types    = ["ipam.prefix"]
+  events           = ["create", "delete"]
+  action_object_id = "${netbox_webhook.ci.id}"
+  conditions       = <<EOT
+{"attr": "status.value", "value": "active"}
+EOT
+}
+`
+
+func TestAccResourceNetboxEventRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxEventRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_webhook.ci", "http_method", "POST"),
+					resource.TestCheckResourceAttr("netbox_event_rule.prefixes", "events.#", "2"),
+					resource.TestCheckResourceAttr("netbox_event_rule.prefixes", "action_object_type", "extras.webhook"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenEvents(t *testing.T) {
+	old := map[string]interface{}{"type_create": true, "type_update": false, "type_delete": true}
+	if got := flattenEvents(old); !reflect.DeepEqual(got, []string{"create", "delete"}) {
+		t.Errorf("flattenEvents(%v) = %v", old, got)
+	}
+	current := map[string]interface{}{"event_types": []interface{}{"object_updated", "job_failed"}}
+	if got := flattenEvents(current); !reflect.DeepEqual(got, []string{"update", "job_end"}) {
+		t.Errorf("flattenEvents(%v) = %v", current, got)
+	}
+}
@@ -0,0 +1,149 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxExportTemplate returns the resource structure for the
+// netbox_export_template resource, a template rendering a list of objects,
+// like a hosts file. Netbox export templates have no tags nor custom fields.
+func resourceNetboxExportTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxExportTemplateCreate,
+		Read:   resourceNetboxExportTemplateRead,
+		Update: resourceNetboxExportTemplateUpdate,
+		Delete: resourceNetboxExportTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceExportTemplateSchema(),
+	}
+}
+
+// expandExportTemplate builds the body of the export template create and
+// update requests.
+func expandExportTemplate(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"description":    d.Get("description").(string),
+		"template_code":  d.Get("template_code").(string),
+		"mime_type":      d.Get("mime_type").(string),
+		"file_extension": d.Get("file_extension").(string),
+		"as_attachment":  d.Get("as_attachment").(bool),
+	}
+	types := expandStringList(d, "content_types")
+	switch {
+	case netboxVersionAtLeast(meta, "4.0"):
+		data["object_types"] = types
+	case netboxVersionAtLeast(meta, "3.5"):
+		data["content_types"] = types
+	case len(types) > 0:
+		data["content_type"] = types[0]
+	}
+	return data
+}
+
+func resourceNetboxExportTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxExportTemplateCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "extras/export-templates/", expandExportTemplate(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxExportTemplateRead(d, meta)
+}
+
+func resourceNetboxExportTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/export-templates/", setExportTemplateFields)
+}
+
+func resourceNetboxExportTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxExportTemplateUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/export-templates/", expandExportTemplate(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxExportTemplateRead(d, meta)
+}
+
+func resourceNetboxExportTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxExportTemplateDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/export-templates/")
+}
+
+// setExportTemplateFields sets the attributes of a netbox_export_template from
+// an API answer.
+func setExportTemplateFields(d *schema.ResourceData, meta interface{}, template map[string]interface{}) {
+	d.Set("name", stringValue(template["name"]))
+	d.Set("content_types", exportTemplateContentTypes(template))
+	d.Set("description", stringValue(template["description"]))
+	d.Set("template_code", stringValue(template["template_code"]))
+	d.Set("mime_type", stringValue(template["mime_type"]))
+	d.Set("file_extension", stringValue(template["file_extension"]))
+	d.Set("as_attachment", boolValue(template["as_attachment"]))
+}
+
+func bareExportTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Models of the template, like "ipam.prefix". Sent as content_type
+		// (a single model) before netbox 3.5, and as object_types on 4.0+.
+		"content_types": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+			Set:  schema.HashString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Jinja2 template, rendered with the objects as queryset.
+		"template_code": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"mime_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"file_extension": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the rendered file is sent as an attachment.
+		"as_attachment": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourceExportTemplateSchema() map[string]*schema.Schema {
+	s := bareExportTemplateSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "content_types", "template_code":
+			v.Required = true
+		case "description", "mime_type", "file_extension":
+			v.Optional = true
+		case "as_attachment":
+			v.Optional = true
+			v.Default = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// exportTemplateContentTypes returns the models of an export template of an
+// API answer, sent as a single content_type before netbox 3.5.
+func exportTemplateContentTypes(template map[string]interface{}) []string {
+	if v, ok := template["object_types"]; ok {
+		return flattenStringList(v)
+	}
+	if v, ok := template["content_types"]; ok {
+		return flattenStringList(v)
+	}
+	if v := stringValue(template["content_type"]); v != "" {
+		return []string{v}
+	}
+	return []string{}
+}
@@ -0,0 +1,76 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxExportTemplateConfig = `
+resource "netbox_vrf" "test" {
+  name = "terraform-test-export"
+}
+
+resource "netbox_prefixes" "test" {
+  prefix = "10.99.1.0/24"
+  vrf_id = "${netbox_vrf.test.id}"
+}
+
+resource "netbox_export_template" "hosts" {
+  name          = "terraform-test-export-prefixes"
+  content_types = ["ipam.prefix"]
+  template_code = "{% for p in queryset %}{{ p.prefix }}\n{% endfor %}"
+  mime_type     = "text/plain"
+}
+
+data "netbox_rendered_export_template" "hosts" {
+  name = "${netbox_export_template.hosts.name}"
+  path = "ipam/prefixes/"
+
+  filters = {
+    vrf_id = "${netbox_prefixes.test.vrf_id}"
+  }
+}
+`
+
+func TestAccResourceNetboxExportTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxExportTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_export_template.hosts", "as_attachment", "true"),
+					resource.TestCheckResourceAttr("data.netbox_rendered_export_template.hosts", "content", "10.99.1.0/24\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestExportTemplateContentTypes(t *testing.T) {
+	for _, template := range []map[string]interface{}{
+		{"content_type": "ipam.prefix"},
+		{"content_types": []interface{}{"ipam.prefix"}},
+		{"object_types": []interface{}{"ipam.prefix"}},
+	} {
+		if got := exportTemplateContentTypes(template); !reflect.DeepEqual(got, []string{"ipam.prefix"}) {
+			t.Errorf("exportTemplateContentTypes(%v) = %v", template, got)
+		}
+	}
+}
+
+func TestValidateAPIPath(t *testing.T) {
+	for path, valid := range map[string]bool{
+		"ipam/prefixes/":          true,
+		"/ipam/prefixes/":         false,
+		"ipam/prefixes":           false,
+		"ipam/prefixes/?export=x": false,
+	} {
+		if _, errs := validateAPIPath(path, "path"); (len(errs) == 0) != valid {
+			t.Errorf("validateAPIPath(%q) = %v", path, errs)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxFrontPort returns the resource structure for the
+// netbox_front_port resource, the front of a patch panel port, mapped to a
+// rear port.
+func resourceNetboxFrontPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFrontPortCreate,
+		Read:   resourceNetboxFrontPortRead,
+		Update: resourceNetboxFrontPortUpdate,
+		Delete: resourceNetboxFrontPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceFrontPortSchema(),
+	}
+}
+
+// expandFrontPort builds the body of the front port create and update
+// requests.
+func expandFrontPort(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"device":             optionalID(d, "device_id"),
+		"name":               d.Get("name").(string),
+		"label":              d.Get("label").(string),
+		"type":               d.Get("type").(string),
+		"rear_port":          optionalID(d, "rear_port_id"),
+		"rear_port_position": d.Get("rear_port_position").(int),
+		"color":              d.Get("color").(string),
+		"description":        d.Get("description").(string),
+		"mark_connected":     d.Get("mark_connected").(bool),
+		"tags":               expandTags(d, meta),
+		"custom_fields":      expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxFrontPortCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxFrontPortCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/front-ports/", expandFrontPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxFrontPortRead(d, meta)
+}
+
+func resourceNetboxFrontPortRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/front-ports/", setFrontPortFields)
+}
+
+func resourceNetboxFrontPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxFrontPortUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/front-ports/", expandFrontPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxFrontPortRead(d, meta)
+}
+
+func resourceNetboxFrontPortDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxFrontPortDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/front-ports/")
+}
+
+// setFrontPortFields sets the attributes of a netbox_front_port from an API
+// answer.
+func setFrontPortFields(d *schema.ResourceData, meta interface{}, port map[string]interface{}) {
+	d.Set("device_id", nestedID(port["device"]))
+	d.Set("name", stringValue(port["name"]))
+	d.Set("label", stringValue(port["label"]))
+	d.Set("type", choiceValue(port["type"]))
+	d.Set("rear_port_id", nestedID(port["rear_port"]))
+	d.Set("rear_port_position", intValue(port["rear_port_position"]))
+	d.Set("color", stringValue(port["color"]))
+	d.Set("description", stringValue(port["description"]))
+	d.Set("mark_connected", boolValue(port["mark_connected"]))
+	d.Set("tags", flattenTags(port["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, port["custom_fields"]))
+	setConnectionFields(d, port)
+}
+
+func bareFrontPortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Physical label, netbox 2.9+.
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the port, like "8p8c" or "lc".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"rear_port_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"rear_port_position": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Color of the port, netbox 3.0+.
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the component is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Endpoint at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourceFrontPortSchema() map[string]*schema.Schema {
+	s := bareFrontPortSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name", "type", "rear_port_id":
+			v.Required = true
+		case "label", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "rear_port_position":
+			v.Optional = true
+			v.Default = 1
+			v.ValidateFunc = validation.IntBetween(1, 1024)
+		case "color":
+			v.Optional = true
+			v.ValidateFunc = validateColor
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,125 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxFrontPortConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-ports-dc"
+  slug = "terraform-test-ports-dc"
+}
+
+resource "netbox_manufacturer" "acme" {
+  name = "terraform-test-ports-acme"
+  slug = "terraform-test-ports-acme"
+}
+
+resource "netbox_device_type" "panel" {
+  manufacturer_id = "${netbox_manufacturer.acme.id}"
+  model           = "terraform-test-ports-panel"
+  slug            = "terraform-test-ports-panel"
+}
+
+resource "netbox_device_role" "network" {
+  name  = "terraform-test-ports-network"
+  slug  = "terraform-test-ports-network"
+  color = "9e9e9e"
+}
+
+resource "netbox_device" "panel" {
+  count          = 2
+  name           = "terraform-test-ports-panel${count.index}"
+  device_type_id = "${netbox_device_type.panel.id}"
+  role_id        = "${netbox_device_role.network.id}"
+  site_id        = "${netbox_site.dc.id}"
+}
+
+resource "netbox_rear_port" "pp1" {
+  count     = 2
+  device_id = "${element(netbox_device.panel.*.id, count.index)}"
+  name      = "1"
+  type      = "8p8c"
+}
+
+resource "netbox_front_port" "pp1" {
+  device_id    = "${netbox_device.panel.0.id}"
+  name         = "1"
+  type         = "8p8c"
+  rear_port_id = "${netbox_rear_port.pp1.0.id}"
+  color        = "2196f3"
+}
+
+resource "netbox_cable" "trunk" {
+  a_termination_type = "dcim.rearport"
+  a_termination_id   = "${netbox_rear_port.pp1.0.id}"
+  b_termination_type = "dcim.rearport"
+  b_termination_id   = "${netbox_rear_port.pp1.1.id}"
+}
+
+resource "netbox_power_port" "psu" {
+  device_id    = "${netbox_device.panel.1.id}"
+  name         = "PSU1"
+  type         = "iec-60320-c14"
+  maximum_draw = 100
+}
+
+resource "netbox_power_outlet" "out" {
+  device_id     = "${netbox_device.panel.1.id}"
+  name          = "OUT1"
+  type          = "iec-60320-c13"
+  power_port_id = "${netbox_power_port.psu.id}"
+  feed_leg      = "A"
+}
+
+resource "netbox_console_port" "con" {
+  device_id      = "${netbox_device.panel.0.id}"
+  name           = "console"
+  type           = "rj-45"
+  mark_connected = true
+}
+`
+
+func TestAccResourceNetboxFrontPort(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxFrontPortConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port.pp1", "rear_port_position", "1"),
+					resource.TestCheckResourceAttr("netbox_rear_port.pp1.0", "positions", "1"),
+					resource.TestCheckResourceAttrPair("netbox_rear_port.pp1.0", "cable_id", "netbox_cable.trunk", "id"),
+					resource.TestCheckResourceAttr("netbox_power_outlet.out", "feed_leg", "A"),
+					resource.TestCheckResourceAttr("netbox_console_port.con", "mark_connected", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestConnectedEndpoint(t *testing.T) {
+	current := map[string]interface{}{
+		"connected_endpoints_type":      "dcim.interface",
+		"connected_endpoints":           []interface{}{map[string]interface{}{"id": 9.0}},
+		"connected_endpoints_reachable": true,
+	}
+	if kind, id, connected := connectedEndpoint(current); kind != "dcim.interface" || id != 9 || !connected {
+		t.Errorf("connectedEndpoint(%v) = %q, %d, %v", current, kind, id, connected)
+	}
+	old := map[string]interface{}{
+		"connected_endpoint_type": "dcim.consoleserverport",
+		"connected_endpoint":      map[string]interface{}{"id": 3.0},
+		"connection_status":       map[string]interface{}{"value": false, "label": "Planned"},
+	}
+	if kind, id, connected := connectedEndpoint(old); kind != "dcim.consoleserverport" || id != 3 || connected {
+		t.Errorf("connectedEndpoint(%v) = %q, %d, %v", old, kind, id, connected)
+	}
+	unconnected := map[string]interface{}{"connected_endpoints": []interface{}{}}
+	if kind, id, connected := connectedEndpoint(unconnected); kind != "" || id != 0 || connected {
+		t.Errorf("connectedEndpoint(%v) = %q, %d, %v", unconnected, kind, id, connected)
+	}
+}
@@ -0,0 +1,92 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxIPRange returns the resource structure for the
+// netbox_ip_range resource (netbox 3.0+), used for the DHCP and static
+// ranges of the prefixes.
+func resourceNetboxIPRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIPRangeCreate,
+		Read:   resourceNetboxIPRangeRead,
+		Update: resourceNetboxIPRangeUpdate,
+		Delete: resourceNetboxIPRangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxIPRangeCustomizeDiff,
+
+		Schema: resourceIPRangeSchema(),
+	}
+}
+
+// resourceNetboxIPRangeCustomizeDiff checks at plan time the custom fields and
+// that both ends of the range are of the same family. Addresses not known yet
+// are skipped.
+func resourceNetboxIPRangeCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomFields(d, meta); err != nil {
+		return err
+	}
+	start := d.Get("start_address").(string)
+	end := d.Get("end_address").(string)
+	if start == "" || end == "" {
+		return nil
+	}
+	startFamily, err := ipFamily(start)
+	if err != nil {
+		return err
+	}
+	endFamily, err := ipFamily(end)
+	if err != nil {
+		return err
+	}
+	if startFamily != endFamily {
+		return fmt.Errorf("start_address %v (IPv%d) and end_address %v (IPv%d) must be of the same family", start, startFamily, end, endFamily)
+	}
+	return nil
+}
+
+// expandIPRange builds the body of the IP range create and update requests.
+func expandIPRange(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"start_address": d.Get("start_address").(string),
+		"end_address":   d.Get("end_address").(string),
+		"vrf":           optionalID(d, "vrf_id"),
+		"tenant":        optionalID(d, "tenant_id"),
+		"status":        d.Get("status").(string),
+		"role":          optionalID(d, "role_id"),
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxIPRangeCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIPRangeCreate: %v - %v\n", d.Get("start_address"), d.Get("end_address"))
+	if err := netboxObjectCreate(d, meta, "ipam/ip-ranges/", expandIPRange(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxIPRangeRead(d, meta)
+}
+
+func resourceNetboxIPRangeRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/ip-ranges/", setIPRangeFields)
+}
+
+func resourceNetboxIPRangeUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIPRangeUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/ip-ranges/", expandIPRange(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxIPRangeRead(d, meta)
+}
+
+func resourceNetboxIPRangeDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIPRangeDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/ip-ranges/")
+}
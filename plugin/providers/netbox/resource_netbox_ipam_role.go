@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxIpamRole returns the resource structure for the
+// netbox_ipam_role resource, the roles of the prefixes and VLANs.
+func resourceNetboxIpamRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpamRoleCreate,
+		Read:   resourceNetboxIpamRoleRead,
+		Update: resourceNetboxIpamRoleUpdate,
+		Delete: resourceNetboxIpamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceIpamRoleSchema(),
+	}
+}
+
+// expandIpamRole builds the body of the role create and update requests.
+func expandIpamRole(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"weight":      d.Get("weight").(int),
+		"description": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxIpamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIpamRoleCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "ipam/roles/", expandIpamRole(d)); err != nil {
+		return err
+	}
+	return resourceNetboxIpamRoleRead(d, meta)
+}
+
+func resourceNetboxIpamRoleRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/roles/", setIpamRoleFields)
+}
+
+func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIpamRoleUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/roles/", expandIpamRole(d)); err != nil {
+		return err
+	}
+	return resourceNetboxIpamRoleRead(d, meta)
+}
+
+func resourceNetboxIpamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxIpamRoleDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/roles/")
+}
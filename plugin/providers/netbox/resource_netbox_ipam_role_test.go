@@ -0,0 +1,47 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxIpamRoleConfig = `
+resource "netbox_ipam_role" "production" {
+  name   = "terraform-test-production"
+  slug   = "terraform-test-production"
+  weight = 100
+}
+
+data "netbox_ipam_role" "production" {
+  slug = "${netbox_ipam_role.production.slug}"
+}
+
+resource "netbox_prefixes" "prefix" {
+  prefix  = "10.101.0.0/24"
+  role_id = "${netbox_ipam_role.production.id}"
+}
+
+resource "netbox_vlans" "vlan" {
+  vid     = 3901
+  name    = "terraform-test-vlan"
+  role_id = "${netbox_ipam_role.production.id}"
+}
+`
+
+func TestAccResourceNetboxIpamRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxIpamRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_ipam_role.production", "weight", "100"),
+					resource.TestCheckResourceAttrPair("netbox_prefixes.prefix", "role_id", "netbox_ipam_role.production", "id"),
+					resource.TestCheckResourceAttrPair("netbox_vlans.vlan", "role_id", "netbox_ipam_role.production", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxJournalEntry returns the resource structure for the
+// netbox_journal_entry resource, a note attached to an object, netbox 2.10+.
+func resourceNetboxJournalEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxJournalEntryCreate,
+		Read:   resourceNetboxJournalEntryRead,
+		Update: resourceNetboxJournalEntryUpdate,
+		Delete: resourceNetboxJournalEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceJournalEntrySchema(),
+	}
+}
+
+// expandJournalEntry builds the body of the journal entry create and update
+// requests.
+func expandJournalEntry(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"assigned_object_type": d.Get("assigned_object_type").(string),
+		"assigned_object_id":   d.Get("assigned_object_id").(int),
+		"kind":                 d.Get("kind").(string),
+		"comments":             d.Get("comments").(string),
+		"tags":                 expandTags(d, meta),
+		"custom_fields":        expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxJournalEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxJournalEntryCreate: %v\n", d.Get("assigned_object_id"))
+	if err := netboxObjectCreate(d, meta, "extras/journal-entries/", expandJournalEntry(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxJournalEntryRead(d, meta)
+}
+
+func resourceNetboxJournalEntryRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/journal-entries/", setJournalEntryFields)
+}
+
+func resourceNetboxJournalEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxJournalEntryUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/journal-entries/", expandJournalEntry(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxJournalEntryRead(d, meta)
+}
+
+func resourceNetboxJournalEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxJournalEntryDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/journal-entries/")
+}
+
+// journalEntryKinds lists the kinds of the journal entries.
+var journalEntryKinds = []string{"info", "success", "warning", "danger"}
+
+// setJournalEntryFields sets the attributes of a netbox_journal_entry from an
+// API answer.
+func setJournalEntryFields(d *schema.ResourceData, meta interface{}, entry map[string]interface{}) {
+	d.Set("assigned_object_type", stringValue(entry["assigned_object_type"]))
+	d.Set("assigned_object_id", intValue(entry["assigned_object_id"]))
+	d.Set("kind", choiceValue(entry["kind"]))
+	d.Set("comments", stringValue(entry["comments"]))
+	d.Set("tags", flattenTags(entry["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, entry["custom_fields"]))
+	d.Set("created", stringValue(entry["created"]))
+	d.Set("created_by", nestedID(entry["created_by"]))
+}
+
+func bareJournalEntrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Object of the entry, like "ipam.prefix" and its ID.
+		"assigned_object_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"assigned_object_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"kind": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Text of the entry, in Markdown.
+		"comments": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// ID of the user that created the entry.
+		"created_by": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+}
+
+func resourceJournalEntrySchema() map[string]*schema.Schema {
+	s := bareJournalEntrySchema()
+	for k, v := range s {
+		switch k {
+		case "assigned_object_type", "assigned_object_id":
+			v.Required = true
+			v.ForceNew = true
+		case "kind":
+			v.Optional = true
+			v.Default = "info"
+			v.ValidateFunc = validation.StringInSlice(journalEntryKinds, false)
+		case "comments":
+			v.Required = true
+		case "tags", "custom_fields":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxJournalEntryConfig = `
+resource "netbox_vrf" "test" {
+  name = "terraform-test-journal"
+}
+
+resource "netbox_journal_entry" "test" {
+  assigned_object_type = "ipam.vrf"
+  assigned_object_id   = "${netbox_vrf.test.id}"
+  kind                 = "success"
+  comments             = "created by terraform-test-journal"
+}
+`
+
+func TestAccResourceNetboxJournalEntry(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxJournalEntryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_journal_entry.test", "kind", "success"),
+					resource.TestCheckResourceAttrSet("netbox_journal_entry.test", "created"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxLocation returns the resource structure for the
+// netbox_location resource.
+func resourceNetboxLocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxLocationCreate,
+		Read:   resourceNetboxLocationRead,
+		Update: resourceNetboxLocationUpdate,
+		Delete: resourceNetboxLocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceLocationSchema(),
+	}
+}
+
+// expandLocation builds the body of the location create and update requests.
+func expandLocation(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"slug":          d.Get("slug").(string),
+		"site":          optionalID(d, "site_id"),
+		"parent":        optionalID(d, "parent_id"),
+		"description":   d.Get("description").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxLocationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxLocationCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, locationPath(meta), expandLocation(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxLocationRead(d, meta)
+}
+
+func resourceNetboxLocationRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, locationPath(meta), setLocationFields)
+}
+
+func resourceNetboxLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxLocationUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, locationPath(meta), expandLocation(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxLocationRead(d, meta)
+}
+
+func resourceNetboxLocationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxLocationDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, locationPath(meta))
+}
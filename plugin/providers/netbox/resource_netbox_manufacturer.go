@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxManufacturer returns the resource structure for the
+// netbox_manufacturer resource.
+func resourceNetboxManufacturer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxManufacturerCreate,
+		Read:   resourceNetboxManufacturerRead,
+		Update: resourceNetboxManufacturerUpdate,
+		Delete: resourceNetboxManufacturerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceManufacturerSchema(),
+	}
+}
+
+// expandManufacturer builds the body of the manufacturer create and update
+// requests.
+func expandManufacturer(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxManufacturerCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxManufacturerCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/manufacturers/", expandManufacturer(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxManufacturerRead(d, meta)
+}
+
+func resourceNetboxManufacturerRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/manufacturers/", setManufacturerFields)
+}
+
+func resourceNetboxManufacturerUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxManufacturerUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/manufacturers/", expandManufacturer(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxManufacturerRead(d, meta)
+}
+
+func resourceNetboxManufacturerDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxManufacturerDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/manufacturers/")
+}
@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxPlatform returns the resource structure for the
+// netbox_platform resource.
+func resourceNetboxPlatform() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPlatformCreate,
+		Read:   resourceNetboxPlatformRead,
+		Update: resourceNetboxPlatformUpdate,
+		Delete: resourceNetboxPlatformDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourcePlatformSchema(),
+	}
+}
+
+// expandPlatform builds the body of the platform create and update requests.
+func expandPlatform(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"slug":         d.Get("slug").(string),
+		"manufacturer": optionalID(d, "manufacturer_id"),
+		"description":  d.Get("description").(string),
+		"tags":         expandTags(d, meta),
+	}
+}
+
+func resourceNetboxPlatformCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPlatformCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/platforms/", expandPlatform(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPlatformRead(d, meta)
+}
+
+func resourceNetboxPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/platforms/", setPlatformFields)
+}
+
+func resourceNetboxPlatformUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPlatformUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/platforms/", expandPlatform(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPlatformRead(d, meta)
+}
+
+func resourceNetboxPlatformDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPlatformDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/platforms/")
+}
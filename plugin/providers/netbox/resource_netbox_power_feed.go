@@ -0,0 +1,77 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxPowerFeed returns the resource structure for the
+// netbox_power_feed resource, a circuit from a power panel, usually to a rack.
+func resourceNetboxPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerFeedCreate,
+		Read:   resourceNetboxPowerFeedRead,
+		Update: resourceNetboxPowerFeedUpdate,
+		Delete: resourceNetboxPowerFeedDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourcePowerFeedSchema(),
+	}
+}
+
+// expandPowerFeed builds the body of the power feed create and update
+// requests.
+func expandPowerFeed(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"power_panel":     optionalID(d, "power_panel_id"),
+		"rack":            optionalID(d, "rack_id"),
+		"status":          expandChoice(meta, d.Get("status").(string), powerFeedStatusLegacy),
+		"type":            expandChoice(meta, d.Get("type").(string), powerFeedTypeLegacy),
+		"supply":          expandChoice(meta, d.Get("supply").(string), powerFeedSupplyLegacy),
+		"phase":           expandChoice(meta, d.Get("phase").(string), powerFeedPhaseLegacy),
+		"voltage":         d.Get("voltage").(int),
+		"amperage":        d.Get("amperage").(int),
+		"max_utilization": d.Get("max_utilization").(int),
+		"mark_connected":  d.Get("mark_connected").(bool),
+		"comments":        d.Get("comments").(string),
+		"tags":            expandTags(d, meta),
+		"custom_fields":   expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "3.3") {
+		data["description"] = d.Get("description").(string)
+	}
+	if netboxVersionAtLeast(meta, "4.0") {
+		data["tenant"] = optionalID(d, "tenant_id")
+	}
+	return data
+}
+
+func resourceNetboxPowerFeedCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerFeedCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/power-feeds/", expandPowerFeed(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerFeedRead(d, meta)
+}
+
+func resourceNetboxPowerFeedRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/power-feeds/", setPowerFeedFields)
+}
+
+func resourceNetboxPowerFeedUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerFeedUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/power-feeds/", expandPowerFeed(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerFeedRead(d, meta)
+}
+
+func resourceNetboxPowerFeedDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerFeedDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/power-feeds/")
+}
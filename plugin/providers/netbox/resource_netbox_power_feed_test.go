@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxPowerFeedConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-power-dc"
+  slug = "terraform-test-power-dc"
+}
+
+resource "netbox_rack" "r1" {
+  name    = "terraform-test-power-r1"
+  site_id = "${netbox_site.dc.id}"
+}
+
+resource "netbox_power_panel" "p1" {
+  name    = "terraform-test-power-p1"
+  site_id = "${netbox_site.dc.id}"
+}
+
+resource "netbox_power_feed" "a" {
+  name            = "terraform-test-power-a"
+  power_panel_id  = "${netbox_power_panel.p1.id}"
+  rack_id         = "${netbox_rack.r1.id}"
+  phase           = "three-phase"
+  voltage         = 230
+  amperage        = 32
+  max_utilization = 90
+}
+
+data "netbox_power_feed" "a" {
+  name           = "${netbox_power_feed.a.name}"
+  power_panel_id = "${netbox_power_panel.p1.id}"
+}
+`
+
+func TestAccResourceNetboxPowerFeed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxPowerFeedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_power_feed.a", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_power_feed.a", "supply", "ac"),
+					resource.TestCheckResourceAttr("netbox_power_feed.a", "connected", "false"),
+					resource.TestCheckResourceAttrPair("data.netbox_power_feed.a", "id", "netbox_power_feed.a", "id"),
+					resource.TestCheckResourceAttr("data.netbox_power_feed.a", "amperage", "32"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxPowerOutlet returns the resource structure for the
+// netbox_power_outlet resource, a power outlet of a device, like a PDU.
+func resourceNetboxPowerOutlet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerOutletCreate,
+		Read:   resourceNetboxPowerOutletRead,
+		Update: resourceNetboxPowerOutletUpdate,
+		Delete: resourceNetboxPowerOutletDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourcePowerOutletSchema(),
+	}
+}
+
+// expandPowerOutlet builds the body of the power outlet create and update
+// requests.
+func expandPowerOutlet(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"device":         optionalID(d, "device_id"),
+		"name":           d.Get("name").(string),
+		"label":          d.Get("label").(string),
+		"type":           d.Get("type").(string),
+		"power_port":     optionalID(d, "power_port_id"),
+		"feed_leg":       optionalChoice(d, meta, "feed_leg", powerOutletFeedLegLegacy),
+		"description":    d.Get("description").(string),
+		"mark_connected": d.Get("mark_connected").(bool),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxPowerOutletCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerOutletCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/power-outlets/", expandPowerOutlet(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerOutletRead(d, meta)
+}
+
+func resourceNetboxPowerOutletRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/power-outlets/", setPowerOutletFields)
+}
+
+func resourceNetboxPowerOutletUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerOutletUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/power-outlets/", expandPowerOutlet(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerOutletRead(d, meta)
+}
+
+func resourceNetboxPowerOutletDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerOutletDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/power-outlets/")
+}
+
+// powerOutletFeedLegLegacy maps the feed legs to the values used before
+// netbox 2.7.
+var powerOutletFeedLegLegacy = map[string]int{
+	"A": 1,
+	"B": 2,
+	"C": 3,
+}
+
+// setPowerOutletFields sets the attributes of a netbox_power_outlet from an
+// API answer.
+func setPowerOutletFields(d *schema.ResourceData, meta interface{}, port map[string]interface{}) {
+	d.Set("device_id", nestedID(port["device"]))
+	d.Set("name", stringValue(port["name"]))
+	d.Set("label", stringValue(port["label"]))
+	d.Set("type", choiceValue(port["type"]))
+	d.Set("power_port_id", nestedID(port["power_port"]))
+	d.Set("feed_leg", flattenChoice(port["feed_leg"], powerOutletFeedLegLegacy))
+	d.Set("description", stringValue(port["description"]))
+	d.Set("mark_connected", boolValue(port["mark_connected"]))
+	d.Set("tags", flattenTags(port["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, port["custom_fields"]))
+	setConnectionFields(d, port)
+}
+
+func barePowerOutletSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Physical label, netbox 2.9+.
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the outlet, like "iec-60320-c13".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Power port that feeds the outlet.
+		"power_port_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Phase of the outlet, for three-phase feeds.
+		"feed_leg": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the component is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Endpoint at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourcePowerOutletSchema() map[string]*schema.Schema {
+	s := barePowerOutletSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name":
+			v.Required = true
+		case "label", "type", "power_port_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "feed_leg":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"A", "B", "C"}, false)
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxPowerPanel returns the resource structure for the
+// netbox_power_panel resource, a panel feeding the racks of a site.
+func resourceNetboxPowerPanel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerPanelCreate,
+		Read:   resourceNetboxPowerPanelRead,
+		Update: resourceNetboxPowerPanelUpdate,
+		Delete: resourceNetboxPowerPanelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourcePowerPanelSchema(),
+	}
+}
+
+// expandPowerPanel builds the body of the power panel create and update
+// requests.
+func expandPowerPanel(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"site":          optionalID(d, "site_id"),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "2.11") {
+		data["location"] = optionalID(d, "location_id")
+	} else {
+		data["rack_group"] = optionalID(d, "location_id")
+	}
+	return data
+}
+
+func resourceNetboxPowerPanelCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPanelCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/power-panels/", expandPowerPanel(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerPanelRead(d, meta)
+}
+
+func resourceNetboxPowerPanelRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/power-panels/", setPowerPanelFields)
+}
+
+func resourceNetboxPowerPanelUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPanelUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/power-panels/", expandPowerPanel(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerPanelRead(d, meta)
+}
+
+func resourceNetboxPowerPanelDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPanelDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/power-panels/")
+}
@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxPowerPort returns the resource structure for the
+// netbox_power_port resource, a power inlet of a device.
+func resourceNetboxPowerPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPowerPortCreate,
+		Read:   resourceNetboxPowerPortRead,
+		Update: resourceNetboxPowerPortUpdate,
+		Delete: resourceNetboxPowerPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourcePowerPortSchema(),
+	}
+}
+
+// expandPowerPort builds the body of the power port create and update
+// requests.
+func expandPowerPort(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"device":         optionalID(d, "device_id"),
+		"name":           d.Get("name").(string),
+		"label":          d.Get("label").(string),
+		"type":           d.Get("type").(string),
+		"maximum_draw":   optionalInt(d, "maximum_draw"),
+		"allocated_draw": optionalInt(d, "allocated_draw"),
+		"description":    d.Get("description").(string),
+		"mark_connected": d.Get("mark_connected").(bool),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxPowerPortCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPortCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/power-ports/", expandPowerPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerPortRead(d, meta)
+}
+
+func resourceNetboxPowerPortRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/power-ports/", setPowerPortFields)
+}
+
+func resourceNetboxPowerPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPortUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/power-ports/", expandPowerPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPowerPortRead(d, meta)
+}
+
+func resourceNetboxPowerPortDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxPowerPortDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/power-ports/")
+}
+
+// setPowerPortFields sets the attributes of a netbox_power_port from an API
+// answer.
+func setPowerPortFields(d *schema.ResourceData, meta interface{}, port map[string]interface{}) {
+	d.Set("device_id", nestedID(port["device"]))
+	d.Set("name", stringValue(port["name"]))
+	d.Set("label", stringValue(port["label"]))
+	d.Set("type", choiceValue(port["type"]))
+	d.Set("maximum_draw", intValue(port["maximum_draw"]))
+	d.Set("allocated_draw", intValue(port["allocated_draw"]))
+	d.Set("description", stringValue(port["description"]))
+	d.Set("mark_connected", boolValue(port["mark_connected"]))
+	d.Set("tags", flattenTags(port["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, port["custom_fields"]))
+	setConnectionFields(d, port)
+}
+
+func barePowerPortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Physical label, netbox 2.9+.
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the port, like "iec-60320-c14".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Maximum power draw in watts.
+		"maximum_draw": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Allocated power draw in watts.
+		"allocated_draw": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the component is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Endpoint at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourcePowerPortSchema() map[string]*schema.Schema {
+	s := barePowerPortSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name":
+			v.Required = true
+		case "label", "type", "maximum_draw", "allocated_draw", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -1,24 +1,23 @@
 package netbox
 
 import (
-	// "errors"
+	"errors"
 	// "fmt"
-	// "strconv"
 	"log"
+	"strconv"
 
-	"github.com/digitalocean/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 // resourceNetboxAddress returns the resource structure for the netbox_address
 // resource.
 //
-// Note that we use the data source read function here to pull down data, as
-// read workflow is identical for both the resource and the data source.
+// The resource reads the prefix with the raw API, as the go-netbox models do
+// not decode the tags of the newer netbox versions.
 func resourceNetboxPrefixes() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxPrefixesCreate,
-		Read:   dataSourceNetboxPrefixesRead,
+		Read:   resourceNetboxPrefixesRead,
 		Update: resourceNetboxPrefixesUpdate,
 		Delete: resourceNetboxPrefixesDelete,
 		Exists: resourceNetboxPrefixesExists,
@@ -37,34 +36,73 @@ func resourceNetboxPrefixesExists(d *schema.ResourceData, meta interface{}) (b b
 // If the API you are using doesn’t provide an ID, you can always use a random Int.
 func resourceNetboxPrefixesCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxPrefixesCreate: %v\n", d)
-	c := meta.(*ProviderNetboxClient).client
-	var parm = ipam.NewIPAMPrefixesCreateParams()
-	log.Println("Criei o parm")
-	parm.Data.ID = int64(d.Get("ip_address").(int))
-	//parm.Set("ID", int64(d.Get("prefixes_id").(int)))
-	//parm.SetCreated(d.Get("prefixes_created"))
-	log.Println("Setei o parm")
+	if d.Get("prefix").(string) == "" {
+		return errors.New("prefix not informed")
+	}
+	if err := netboxObjectCreate(d, meta, "ipam/prefixes/", expandPrefixes(d, meta)); err != nil {
+		log.Printf("erro na chamada do POST prefixes\n")
+		log.Printf("Err: %v\n", err)
+		return err
+	}
+	id, _ := strconv.Atoi(d.Id())
+	d.Set("prefixes_id", id)
+	return resourceNetboxPrefixesRead(d, meta)
+}
 
-	//parms = ipam.NewIPAMPrefixesListParams()
-	out, err := c.IPAM.IPAMPrefixesCreate(parm, nil)
-	log.Printf("- Executado...\n")
-	print("out %v\n", out)
-	print("err %v\n", err)
+func resourceNetboxPrefixesRead(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" && d.Get("prefixes_id").(int) != 0 {
+		d.SetId(strconv.Itoa(d.Get("prefixes_id").(int)))
+	}
+	return netboxObjectRead(d, meta, "ipam/prefixes/", setPrefixesFields)
+}
 
-	return nil
+// setPrefixesFields sets the attributes of a netbox_prefixes from an API
+// answer.
+func setPrefixesFields(d *schema.ResourceData, meta interface{}, prefix map[string]interface{}) {
+	d.Set("prefixes_id", nestedID(prefix["id"]))
+	d.Set("prefix", stringValue(prefix["prefix"]))
+	d.Set("description", stringValue(prefix["description"]))
+	d.Set("family", choiceValue(prefix["family"]))
+	d.Set("is_pool", boolValue(prefix["is_pool"]))
+	d.Set("vlan_id", nestedID(prefix["vlan"]))
+	d.Set("vlan_vid", 0)
+	if vlan, ok := prefix["vlan"].(map[string]interface{}); ok {
+		d.Set("vlan_vid", intValue(vlan["vid"]))
+	}
+	d.Set("vrf_id", nestedID(prefix["vrf"]))
+	d.Set("role_id", nestedID(prefix["role"]))
+	d.Set("tenant_id", nestedID(prefix["tenant"]))
+	d.Set("tags", flattenTags(prefix["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, prefix["custom_fields"]))
+	d.Set("created", stringValue(prefix["created"]))
+	d.Set("last_updated", stringValue(prefix["last_updated"]))
 }
 
-//Update is optional if your Resource doesn’t support update.
-//For example, I’m not using update in the Terraform LDAP Provider.
-//I just destroy and recreate the resource everytime there is a change.
+// expandPrefixes builds the body of the prefix create and update requests.
+func expandPrefixes(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"prefix":      d.Get("prefix").(string),
+		"description": d.Get("description").(string),
+		"vlan":        optionalID(d, "vlan_id"),
+		"vrf":         optionalID(d, "vrf_id"),
+		"role":        optionalID(d, "role_id"),
+		"tenant":      optionalID(d, "tenant_id"),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+// Update is optional if your Resource doesn’t support update.
+// For example, I’m not using update in the Terraform LDAP Provider.
+// I just destroy and recreate the resource everytime there is a change.
 func resourceNetboxPrefixesUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxPrefixesUpdate: %v\n", d)
-
-	return nil
+	if err := netboxObjectUpdate(d, meta, "ipam/prefixes/", expandPrefixes(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxPrefixesRead(d, meta)
 }
 
 func resourceNetboxPrefixesDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxPrefixesDelete: %v\n", d)
-	d.SetId("")
-	return nil
+	return netboxObjectDelete(d, meta, "ipam/prefixes/")
 }
@@ -0,0 +1,169 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxPrimaryIP returns the resource structure for the
+// netbox_primary_ip resource, that sets an address as the primary IPv4 or
+// IPv6 of a device or a virtual machine. The ID of the resource is the ID of
+// the address.
+func resourceNetboxPrimaryIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxPrimaryIPCreate,
+		Read:   resourceNetboxPrimaryIPRead,
+		Delete: resourceNetboxPrimaryIPDelete,
+
+		CustomizeDiff: resourceNetboxPrimaryIPCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"ip_address_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"device_id": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"virtual_machine_id"},
+			},
+			"virtual_machine_id": &schema.Schema{
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"device_id"},
+			},
+			// 4 or 6, from the family of the address.
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxPrimaryIPCustomizeDiff checks at plan time that the address
+// has exactly one owner. ConflictsWith only covers the case of both.
+func resourceNetboxPrimaryIPCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("device_id") || !d.NewValueKnown("virtual_machine_id") {
+		return nil
+	}
+	if d.Get("device_id").(int) == 0 && d.Get("virtual_machine_id").(int) == 0 {
+		return errors.New("one of device_id or virtual_machine_id must be informed")
+	}
+	return nil
+}
+
+// primaryIPOwner returns the list path and the ID of the device or virtual
+// machine of a netbox_primary_ip.
+func primaryIPOwner(d *schema.ResourceData) (string, string) {
+	if id := d.Get("device_id").(int); id != 0 {
+		return "dcim/devices/", strconv.Itoa(id)
+	}
+	return "virtualization/virtual-machines/", strconv.Itoa(d.Get("virtual_machine_id").(int))
+}
+
+// primaryIPOwnerPath returns the path of the device or virtual machine of a
+// netbox_primary_ip.
+func primaryIPOwnerPath(d *schema.ResourceData) string {
+	path, id := primaryIPOwner(d)
+	return path + id + "/"
+}
+
+// ipAddressOwner returns the kind ("device" or "virtual_machine") and the ID
+// of the owner of the interface an address of an API answer is assigned to,
+// or "" when the address is not assigned to an interface.
+func ipAddressOwner(address map[string]interface{}) (string, int) {
+	iface, ok := address["assigned_object"].(map[string]interface{})
+	if !ok {
+		// Netbox before 2.9.
+		iface, ok = address["interface"].(map[string]interface{})
+	}
+	if !ok {
+		return "", 0
+	}
+	for _, kind := range []string{"device", "virtual_machine"} {
+		if id := nestedID(iface[kind]); id != 0 {
+			return kind, id
+		}
+	}
+	return "", 0
+}
+
+// Create checks that the address is assigned to an interface of the owner
+// before setting it as its primary address.
+func resourceNetboxPrimaryIPCreate(d *schema.ResourceData, meta interface{}) error {
+	id := strconv.Itoa(d.Get("ip_address_id").(int))
+	log.Printf("[DEBUG] resourceNetboxPrimaryIPCreate: %v\n", id)
+	var address map[string]interface{}
+	if err := netboxAPIRequest(meta, "GET", "ipam/ip-addresses/"+id+"/", nil, &address); err != nil {
+		return err
+	}
+	kind, ownerID := ipAddressOwner(address)
+	if kind == "" || d.Get(kind+"_id").(int) != ownerID {
+		return fmt.Errorf("IP address %v is not assigned to an interface of the %s", stringValue(address["address"]), primaryIPOwnerPath(d))
+	}
+	family, err := ipFamily(stringValue(address["address"]))
+	if err != nil {
+		return err
+	}
+	data := map[string]interface{}{
+		fmt.Sprintf("primary_ip%d", family): d.Get("ip_address_id").(int),
+	}
+	if err := netboxAPIRequest(meta, "PATCH", primaryIPOwnerPath(d), data, nil); err != nil {
+		return err
+	}
+	ownerPath, owner := primaryIPOwner(d)
+	netboxJournal(meta, ownerPath, owner, "Updated")
+	d.SetId(id)
+	d.Set("ip_version", family)
+	return resourceNetboxPrimaryIPRead(d, meta)
+}
+
+// Read removes the resource from the state when the address is not the
+// primary one of its owner anymore.
+func resourceNetboxPrimaryIPRead(d *schema.ResourceData, meta interface{}) error {
+	var owner map[string]interface{}
+	err := netboxAPIRequest(meta, "GET", primaryIPOwnerPath(d), nil, &owner)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	primary := fmt.Sprintf("primary_ip%d", d.Get("ip_version").(int))
+	if strconv.Itoa(nestedID(owner[primary])) != d.Id() {
+		log.Printf("[DEBUG] %v is not the %s of %v anymore\n", d.Id(), primary, primaryIPOwnerPath(d))
+		d.SetId("")
+	}
+	return nil
+}
+
+// Delete unsets the primary address of the owner, unless it was changed to
+// another one.
+func resourceNetboxPrimaryIPDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceNetboxPrimaryIPRead(d, meta); err != nil || d.Id() == "" {
+		return err
+	}
+	data := map[string]interface{}{
+		fmt.Sprintf("primary_ip%d", d.Get("ip_version").(int)): nil,
+	}
+	err := netboxAPIRequest(meta, "PATCH", primaryIPOwnerPath(d), data, nil)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ownerPath, owner := primaryIPOwner(d)
+	netboxJournal(meta, ownerPath, owner, "Updated")
+	d.SetId("")
+	return nil
+}
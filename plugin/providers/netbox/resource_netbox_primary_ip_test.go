@@ -0,0 +1,79 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxPrimaryIPConfig = `
+resource "netbox_cluster_type" "kvm" {
+  name = "terraform-test-kvm"
+  slug = "terraform-test-kvm"
+}
+
+resource "netbox_cluster" "c2" {
+  name    = "terraform-test-c2"
+  type_id = "${netbox_cluster_type.kvm.id}"
+}
+
+resource "netbox_virtual_machine" "vm2" {
+  name       = "terraform-test-vm2"
+  cluster_id = "${netbox_cluster.c2.id}"
+}
+
+resource "netbox_vm_interface" "eth0" {
+  virtual_machine_id = "${netbox_virtual_machine.vm2.id}"
+  name               = "eth0"
+}
+
+resource "netbox_prefixes" "vms" {
+  prefix = "10.106.0.0/24"
+}
+
+resource "netbox_prefixes_available_ips" "vm2" {
+  prefixes_id          = "${netbox_prefixes.vms.id}"
+  description          = "terraform-test-vm2"
+  assigned_object_type = "virtualization.vminterface"
+  assigned_object_id   = "${netbox_vm_interface.eth0.id}"
+}
+
+resource "netbox_primary_ip" "vm2" {
+  virtual_machine_id = "${netbox_virtual_machine.vm2.id}"
+  ip_address_id      = "${netbox_prefixes_available_ips.vm2.id}"
+}
+`
+
+func TestAccResourceNetboxPrimaryIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxPrimaryIPConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_primary_ip.vm2", "ip_version", "4"),
+					resource.TestCheckResourceAttrPair("netbox_primary_ip.vm2", "id", "netbox_prefixes_available_ips.vm2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestIPAddressOwner(t *testing.T) {
+	cases := []struct {
+		address map[string]interface{}
+		kind    string
+		id      int
+	}{
+		{map[string]interface{}{"assigned_object": map[string]interface{}{"id": 1.0, "device": map[string]interface{}{"id": 2.0}}}, "device", 2},
+		{map[string]interface{}{"assigned_object": map[string]interface{}{"id": 1.0, "virtual_machine": map[string]interface{}{"id": 3.0}}}, "virtual_machine", 3},
+		{map[string]interface{}{"interface": map[string]interface{}{"id": 1.0, "device": map[string]interface{}{"id": 4.0}, "virtual_machine": nil}}, "device", 4},
+		{map[string]interface{}{"assigned_object": nil}, "", 0},
+	}
+	for _, c := range cases {
+		if kind, id := ipAddressOwner(c.address); kind != c.kind || id != c.id {
+			t.Errorf("ipAddressOwner(%v) = %q, %d, want %q, %d", c.address, kind, id, c.kind, c.id)
+		}
+	}
+}
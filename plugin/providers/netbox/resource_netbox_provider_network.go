@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxProviderNetwork returns the resource structure for the
+// netbox_provider_network resource, a network of a provider circuits terminate
+// on, like an MPLS cloud, netbox 2.10+.
+func resourceNetboxProviderNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxProviderNetworkCreate,
+		Read:   resourceNetboxProviderNetworkRead,
+		Update: resourceNetboxProviderNetworkUpdate,
+		Delete: resourceNetboxProviderNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceProviderNetworkSchema(),
+	}
+}
+
+// expandProviderNetwork builds the body of the provider network create and
+// update requests.
+func expandProviderNetwork(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"provider":      optionalID(d, "provider_id"),
+		"service_id":    d.Get("service_id").(string),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxProviderNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxProviderNetworkCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "circuits/provider-networks/", expandProviderNetwork(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxProviderNetworkRead(d, meta)
+}
+
+func resourceNetboxProviderNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "circuits/provider-networks/", setProviderNetworkFields)
+}
+
+func resourceNetboxProviderNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxProviderNetworkUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "circuits/provider-networks/", expandProviderNetwork(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxProviderNetworkRead(d, meta)
+}
+
+func resourceNetboxProviderNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxProviderNetworkDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "circuits/provider-networks/")
+}
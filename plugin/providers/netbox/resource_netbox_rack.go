@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxRack returns the resource structure for the netbox_rack
+// resource.
+func resourceNetboxRack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRackCreate,
+		Read:   resourceNetboxRackRead,
+		Update: resourceNetboxRackUpdate,
+		Delete: resourceNetboxRackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceRackSchema(),
+	}
+}
+
+// expandRack builds the body of the rack create and update requests.
+func expandRack(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"site":          optionalID(d, "site_id"),
+		"role":          optionalID(d, "role_id"),
+		"status":        expandChoice(meta, d.Get("status").(string), rackStatusLegacy),
+		"facility_id":   optionalString(d, "facility_id"),
+		"tenant":        optionalID(d, "tenant_id"),
+		"serial":        d.Get("serial").(string),
+		"asset_tag":     optionalString(d, "asset_tag"),
+		"width":         d.Get("width").(int),
+		"u_height":      d.Get("u_height").(int),
+		"desc_units":    d.Get("desc_units").(bool),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "2.11") {
+		data["location"] = optionalID(d, "location_id")
+	} else {
+		data["group"] = optionalID(d, "location_id")
+	}
+	return data
+}
+
+func resourceNetboxRackCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/racks/", expandRack(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRackRead(d, meta)
+}
+
+func resourceNetboxRackRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/racks/", setRackFields)
+}
+
+func resourceNetboxRackUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/racks/", expandRack(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRackRead(d, meta)
+}
+
+func resourceNetboxRackDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/racks/")
+}
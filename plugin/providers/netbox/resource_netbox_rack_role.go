@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxRackRole returns the resource structure for the
+// netbox_rack_role resource.
+func resourceNetboxRackRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRackRoleCreate,
+		Read:   resourceNetboxRackRoleRead,
+		Update: resourceNetboxRackRoleUpdate,
+		Delete: resourceNetboxRackRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceRackRoleSchema(),
+	}
+}
+
+// expandRackRole builds the body of the rack role create and update requests.
+func expandRackRole(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"color":       d.Get("color").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+}
+
+func resourceNetboxRackRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackRoleCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/rack-roles/", expandRackRole(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRackRoleRead(d, meta)
+}
+
+func resourceNetboxRackRoleRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/rack-roles/", setRackRoleFields)
+}
+
+func resourceNetboxRackRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackRoleUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/rack-roles/", expandRackRole(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRackRoleRead(d, meta)
+}
+
+func resourceNetboxRackRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRackRoleDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/rack-roles/")
+}
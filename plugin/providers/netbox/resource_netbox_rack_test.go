@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxRackConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-rack-dc"
+  slug = "terraform-test-rack-dc"
+}
+
+resource "netbox_location" "room" {
+  name    = "terraform-test-room"
+  slug    = "terraform-test-room"
+  site_id = "${netbox_site.dc.id}"
+}
+
+resource "netbox_rack_role" "network" {
+  name  = "terraform-test-network"
+  slug  = "terraform-test-network"
+  color = "ff9800"
+}
+
+resource "netbox_rack" "r1" {
+  name        = "terraform-test-r1"
+  site_id     = "${netbox_site.dc.id}"
+  location_id = "${netbox_location.room.id}"
+  role_id     = "${netbox_rack_role.network.id}"
+  u_height    = 10
+}
+
+data "netbox_rack_units" "r1" {
+  rack_id  = "${netbox_rack.r1.id}"
+  u_height = 2
+}
+`
+
+func TestAccResourceNetboxRack(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxRackConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_rack.r1", "location_id", "netbox_location.room", "id"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.r1", "free_units.#", "10"),
+					resource.TestCheckResourceAttr("data.netbox_rack_units.r1", "position", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFirstFreePosition(t *testing.T) {
+	// units returns whole free units at the positions, and occupied units
+	// at the negative ones.
+	units := func(positions ...float64) []rackUnit {
+		r := []rackUnit{}
+		for _, p := range positions {
+			r = append(r, rackUnit{position: math.Abs(p), free: p > 0})
+		}
+		return r
+	}
+	cases := []struct {
+		units  []rackUnit
+		height int
+		want   float64
+	}{
+		{units(), 1, 0},
+		{units(3, 4, 5), 1, 3},
+		{units(1, 3, 4, 5, 8), 2, 3},
+		{units(1, 3, 4, 5, 8), 3, 3},
+		{units(1, 3, 4, 5, 8), 4, 0},
+		{units(1, -2, 3, 4), 2, 3},
+		// A half unit device at 1 leaves the half unit 1.5 free.
+		{units(-1, 1.5, 2, 3), 2, 1.5},
+		// A half unit device at 2.5 cuts the unit 2.
+		{units(1, 2, -2.5, 3), 2, 0},
+	}
+	for _, c := range cases {
+		if got := firstFreePosition(c.units, c.height); got != c.want {
+			t.Errorf("firstFreePosition(%v, %d) = %v, want %v", c.units, c.height, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxRearPort returns the resource structure for the
+// netbox_rear_port resource, the back of a patch panel port.
+func resourceNetboxRearPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRearPortCreate,
+		Read:   resourceNetboxRearPortRead,
+		Update: resourceNetboxRearPortUpdate,
+		Delete: resourceNetboxRearPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceRearPortSchema(),
+	}
+}
+
+// expandRearPort builds the body of the rear port create and update requests.
+func expandRearPort(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"device":         optionalID(d, "device_id"),
+		"name":           d.Get("name").(string),
+		"label":          d.Get("label").(string),
+		"type":           d.Get("type").(string),
+		"positions":      d.Get("positions").(int),
+		"color":          d.Get("color").(string),
+		"description":    d.Get("description").(string),
+		"mark_connected": d.Get("mark_connected").(bool),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxRearPortCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRearPortCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/rear-ports/", expandRearPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRearPortRead(d, meta)
+}
+
+func resourceNetboxRearPortRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/rear-ports/", setRearPortFields)
+}
+
+func resourceNetboxRearPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRearPortUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/rear-ports/", expandRearPort(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxRearPortRead(d, meta)
+}
+
+func resourceNetboxRearPortDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRearPortDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/rear-ports/")
+}
+
+// setRearPortFields sets the attributes of a netbox_rear_port from an API
+// answer.
+func setRearPortFields(d *schema.ResourceData, meta interface{}, port map[string]interface{}) {
+	d.Set("device_id", nestedID(port["device"]))
+	d.Set("name", stringValue(port["name"]))
+	d.Set("label", stringValue(port["label"]))
+	d.Set("type", choiceValue(port["type"]))
+	d.Set("positions", intValue(port["positions"]))
+	d.Set("color", stringValue(port["color"]))
+	d.Set("description", stringValue(port["description"]))
+	d.Set("mark_connected", boolValue(port["mark_connected"]))
+	d.Set("tags", flattenTags(port["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, port["custom_fields"]))
+	setConnectionFields(d, port)
+}
+
+func bareRearPortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Physical label, netbox 2.9+.
+		"label": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Type of the port, like "8p8c" or "lc".
+		"type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Number of front ports that may be mapped to the port.
+		"positions": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Color of the port, netbox 3.0+.
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Whether the component is connected without a cable, netbox 3.0+.
+		"mark_connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+		"cable_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Endpoint at the far end of the cable path.
+		"connected_endpoint_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"connected_endpoint_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"connected": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func resourceRearPortSchema() map[string]*schema.Schema {
+	s := bareRearPortSchema()
+	for k, v := range s {
+		switch k {
+		case "device_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name", "type":
+			v.Required = true
+		case "label", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "positions":
+			v.Optional = true
+			v.Default = 1
+			v.ValidateFunc = validation.IntBetween(1, 1024)
+		case "color":
+			v.Optional = true
+			v.ValidateFunc = validateColor
+		case "mark_connected":
+			v.Optional = true
+			v.Default = false
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
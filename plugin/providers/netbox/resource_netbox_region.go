@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxRegion returns the resource structure for the netbox_region
+// resource, regions can be nested with parent_id.
+func resourceNetboxRegion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRegionCreate,
+		Read:   resourceNetboxRegionRead,
+		Update: resourceNetboxRegionUpdate,
+		Delete: resourceNetboxRegionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceRegionSchema(),
+	}
+}
+
+// expandRegion builds the body of the region create and update requests.
+func expandRegion(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"parent":      optionalID(d, "parent_id"),
+		"description": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxRegionCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRegionCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/regions/", expandRegion(d)); err != nil {
+		return err
+	}
+	return resourceNetboxRegionRead(d, meta)
+}
+
+func resourceNetboxRegionRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/regions/", setRegionFields)
+}
+
+func resourceNetboxRegionUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRegionUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/regions/", expandRegion(d)); err != nil {
+		return err
+	}
+	return resourceNetboxRegionRead(d, meta)
+}
+
+func resourceNetboxRegionDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRegionDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/regions/")
+}
@@ -0,0 +1,57 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxRir returns the resource structure for the netbox_rir
+// resource.
+func resourceNetboxRir() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxRirCreate,
+		Read:   resourceNetboxRirRead,
+		Update: resourceNetboxRirUpdate,
+		Delete: resourceNetboxRirDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceRirSchema(),
+	}
+}
+
+// expandRir builds the body of the RIR create and update requests.
+func expandRir(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       d.Get("name").(string),
+		"slug":       d.Get("slug").(string),
+		"is_private": d.Get("is_private").(bool),
+	}
+}
+
+func resourceNetboxRirCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRirCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "ipam/rirs/", expandRir(d)); err != nil {
+		return err
+	}
+	return resourceNetboxRirRead(d, meta)
+}
+
+func resourceNetboxRirRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/rirs/", setRirFields)
+}
+
+func resourceNetboxRirUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRirUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/rirs/", expandRir(d)); err != nil {
+		return err
+	}
+	return resourceNetboxRirRead(d, meta)
+}
+
+func resourceNetboxRirDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxRirDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/rirs/")
+}
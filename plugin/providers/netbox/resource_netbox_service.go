@@ -0,0 +1,219 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// serviceProtocolLegacy maps the service protocols to the values used before
+// netbox 2.7.
+var serviceProtocolLegacy = map[string]int{
+	"tcp": 6,
+	"udp": 17,
+}
+
+// resourceNetboxService returns the resource structure for the
+// netbox_service resource, the ports a device or a virtual machine listens
+// on.
+func resourceNetboxService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxServiceCreate,
+		Read:   resourceNetboxServiceRead,
+		Update: resourceNetboxServiceUpdate,
+		Delete: resourceNetboxServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxServiceCustomizeDiff,
+
+		Schema: resourceServiceSchema(),
+	}
+}
+
+func bareServiceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"protocol": &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "sctp"}, false),
+		},
+		"ports": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+		},
+		"device_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"virtual_machine_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"ipaddresses": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags": tagsSchema(),
+		"created": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"last_updated": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceServiceSchema() map[string]*schema.Schema {
+	s := bareServiceSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "protocol", "ports":
+			v.Required = true
+		case "device_id":
+			v.Optional = true
+			v.ConflictsWith = []string{"virtual_machine_id"}
+		case "virtual_machine_id":
+			v.Optional = true
+			v.ConflictsWith = []string{"device_id"}
+		case "ipaddresses", "description", "tags":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// resourceNetboxServiceCustomizeDiff checks at plan time that the service
+// has exactly one parent. ConflictsWith only covers the case of both.
+func resourceNetboxServiceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("device_id") || !d.NewValueKnown("virtual_machine_id") {
+		return nil
+	}
+	_, _, err := serviceParent(d.Get("device_id").(int), d.Get("virtual_machine_id").(int))
+	return err
+}
+
+// serviceParent returns the type and id of the parent object of a service,
+// which must be exactly one of a device or a virtual machine.
+func serviceParent(deviceID int, virtualMachineID int) (string, int, error) {
+	switch {
+	case deviceID != 0 && virtualMachineID != 0:
+		return "", 0, errors.New("only one of device_id or virtual_machine_id can be informed")
+	case deviceID != 0:
+		return "dcim.device", deviceID, nil
+	case virtualMachineID != 0:
+		return "virtualization.virtualmachine", virtualMachineID, nil
+	}
+	return "", 0, errors.New("one of device_id or virtual_machine_id must be informed")
+}
+
+// expandService builds the body of the service create and update requests.
+// netbox 2.10 replaced the port by a list of ports, and netbox 4.3 replaced
+// the device and virtual machine by a parent object.
+func expandService(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	ports := expandIDSet(d, "ports")
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"protocol":    expandChoice(meta, d.Get("protocol").(string), serviceProtocolLegacy),
+		"ipaddresses": expandIDSet(d, "ipaddresses"),
+		"description": d.Get("description").(string),
+		"tags":        expandTags(d, meta),
+	}
+	if netboxVersionAtLeast(meta, "2.10") {
+		data["ports"] = ports
+	} else if len(ports) == 1 {
+		data["port"] = ports[0]
+	} else {
+		return nil, fmt.Errorf("netbox %v only supports one port per service", netboxAPIVersion(meta))
+	}
+	parentType, parentID, err := serviceParent(d.Get("device_id").(int), d.Get("virtual_machine_id").(int))
+	if err != nil {
+		return nil, err
+	}
+	if netboxVersionAtLeast(meta, "4.3") {
+		data["parent_object_type"] = parentType
+		data["parent_object_id"] = parentID
+	} else {
+		data["device"] = optionalID(d, "device_id")
+		data["virtual_machine"] = optionalID(d, "virtual_machine_id")
+	}
+	return data, nil
+}
+
+// setServiceFields sets the attributes of a netbox_service from an API
+// answer.
+func setServiceFields(d *schema.ResourceData, meta interface{}, service map[string]interface{}) {
+	d.Set("name", stringValue(service["name"]))
+	d.Set("protocol", flattenChoice(service["protocol"], serviceProtocolLegacy))
+	if l, ok := service["ports"].([]interface{}); ok {
+		ports := []int{}
+		for _, port := range l {
+			ports = append(ports, intValue(port))
+		}
+		d.Set("ports", ports)
+	} else {
+		d.Set("ports", []int{intValue(service["port"])})
+	}
+	if parentType, ok := service["parent_object_type"]; ok {
+		d.Set("device_id", 0)
+		d.Set("virtual_machine_id", 0)
+		switch stringValue(parentType) {
+		case "dcim.device":
+			d.Set("device_id", intValue(service["parent_object_id"]))
+		case "virtualization.virtualmachine":
+			d.Set("virtual_machine_id", intValue(service["parent_object_id"]))
+		}
+	} else {
+		d.Set("device_id", nestedID(service["device"]))
+		d.Set("virtual_machine_id", nestedID(service["virtual_machine"]))
+	}
+	d.Set("ipaddresses", flattenIDList(service["ipaddresses"]))
+	d.Set("description", stringValue(service["description"]))
+	d.Set("tags", flattenTags(service["tags"]))
+	d.Set("created", stringValue(service["created"]))
+	d.Set("last_updated", stringValue(service["last_updated"]))
+}
+
+func resourceNetboxServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxServiceCreate: %v\n", d.Get("name"))
+	data, err := expandService(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := netboxObjectCreate(d, meta, "ipam/services/", data); err != nil {
+		return err
+	}
+	return resourceNetboxServiceRead(d, meta)
+}
+
+func resourceNetboxServiceRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/services/", setServiceFields)
+}
+
+func resourceNetboxServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxServiceUpdate: %v\n", d.Id())
+	data, err := expandService(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := netboxObjectUpdate(d, meta, "ipam/services/", data); err != nil {
+		return err
+	}
+	return resourceNetboxServiceRead(d, meta)
+}
+
+func resourceNetboxServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxServiceDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/services/")
+}
@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxServiceConfig = `
+resource "netbox_site" "dc" {
+  name = "terraform-test-service-dc"
+  slug = "terraform-test-service-dc"
+}
+
+resource "netbox_manufacturer" "acme" {
+  name = "terraform-test-service-acme"
+  slug = "terraform-test-service-acme"
+}
+
+resource "netbox_device_type" "server" {
+  manufacturer_id = "${netbox_manufacturer.acme.id}"
+  model           = "terraform-test-service-server"
+  slug            = "terraform-test-service-server"
+}
+
+resource "netbox_device_role" "server" {
+  name  = "terraform-test-service-server"
+  slug  = "terraform-test-service-server"
+  color = "9e9e9e"
+}
+
+resource "netbox_device" "srv1" {
+  name           = "terraform-test-service-srv1"
+  device_type_id = "${netbox_device_type.server.id}"
+  role_id        = "${netbox_device_role.server.id}"
+  site_id        = "${netbox_site.dc.id}"
+}
+
+resource "netbox_cluster_type" "vmware" {
+  name = "terraform-test-service-vmware"
+  slug = "terraform-test-service-vmware"
+}
+
+resource "netbox_cluster" "c1" {
+  name    = "terraform-test-service-c1"
+  type_id = "${netbox_cluster_type.vmware.id}"
+}
+
+resource "netbox_virtual_machine" "vm1" {
+  name       = "terraform-test-service-vm1"
+  cluster_id = "${netbox_cluster.c1.id}"
+}
+
+resource "netbox_service" "ssh" {
+  device_id   = "${netbox_device.srv1.id}"
+  name        = "ssh"
+  protocol    = "tcp"
+  ports       = [22]
+  description = "Terraform test service"
+}
+
+resource "netbox_service" "dns" {
+  virtual_machine_id = "${netbox_virtual_machine.vm1.id}"
+  name               = "dns"
+  protocol           = "udp"
+  ports              = [53, 5353]
+}
+`
+
+func TestAccResourceNetboxService(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxServiceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_service.ssh", "device_id", "netbox_device.srv1", "id"),
+					resource.TestCheckResourceAttr("netbox_service.ssh", "virtual_machine_id", "0"),
+					resource.TestCheckResourceAttr("netbox_service.ssh", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("netbox_service.ssh", "ports.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_service.dns", "virtual_machine_id", "netbox_virtual_machine.vm1", "id"),
+					resource.TestCheckResourceAttr("netbox_service.dns", "device_id", "0"),
+					resource.TestCheckResourceAttr("netbox_service.dns", "ports.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceParent(t *testing.T) {
+	cases := []struct {
+		deviceID, virtualMachineID int
+		wantType                   string
+		wantID                     int
+		wantErr                    bool
+	}{
+		{1, 0, "dcim.device", 1, false},
+		{0, 2, "virtualization.virtualmachine", 2, false},
+		{0, 0, "", 0, true},
+		{1, 2, "", 0, true},
+	}
+	for _, c := range cases {
+		parentType, parentID, err := serviceParent(c.deviceID, c.virtualMachineID)
+		if (err != nil) != c.wantErr {
+			t.Errorf("serviceParent(%d, %d) error = %v, want error %v", c.deviceID, c.virtualMachineID, err, c.wantErr)
+		}
+		if parentType != c.wantType || parentID != c.wantID {
+			t.Errorf("serviceParent(%d, %d) = %q, %d, want %q, %d", c.deviceID, c.virtualMachineID, parentType, parentID, c.wantType, c.wantID)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxSite returns the resource structure for the netbox_site
+// resource.
+func resourceNetboxSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSiteCreate,
+		Read:   resourceNetboxSiteRead,
+		Update: resourceNetboxSiteUpdate,
+		Delete: resourceNetboxSiteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceSiteSchema(),
+	}
+}
+
+// expandSite builds the body of the site create and update requests.
+func expandSite(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             d.Get("name").(string),
+		"slug":             d.Get("slug").(string),
+		"status":           expandChoice(meta, d.Get("status").(string), siteStatusLegacy),
+		"region":           optionalID(d, "region_id"),
+		"group":            optionalID(d, "group_id"),
+		"tenant":           optionalID(d, "tenant_id"),
+		"facility":         d.Get("facility").(string),
+		"time_zone":        d.Get("time_zone").(string),
+		"physical_address": d.Get("physical_address").(string),
+		"shipping_address": d.Get("shipping_address").(string),
+		"latitude":         optionalFloat(d, "latitude"),
+		"longitude":        optionalFloat(d, "longitude"),
+		"description":      d.Get("description").(string),
+		"tags":             expandTags(d, meta),
+		"custom_fields":    expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxSiteCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/sites/", expandSite(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxSiteRead(d, meta)
+}
+
+func resourceNetboxSiteRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/sites/", setSiteFields)
+}
+
+func resourceNetboxSiteUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/sites/", expandSite(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxSiteRead(d, meta)
+}
+
+func resourceNetboxSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/sites/")
+}
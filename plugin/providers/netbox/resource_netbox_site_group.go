@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxSiteGroup returns the resource structure for the
+// netbox_site_group resource (netbox 3.0+), site groups can be nested with
+// parent_id.
+func resourceNetboxSiteGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSiteGroupCreate,
+		Read:   resourceNetboxSiteGroupRead,
+		Update: resourceNetboxSiteGroupUpdate,
+		Delete: resourceNetboxSiteGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceSiteGroupSchema(),
+	}
+}
+
+// expandSiteGroup builds the body of the site group create and update
+// requests.
+func expandSiteGroup(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"parent":      optionalID(d, "parent_id"),
+		"description": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxSiteGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteGroupCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "dcim/site-groups/", expandSiteGroup(d)); err != nil {
+		return err
+	}
+	return resourceNetboxSiteGroupRead(d, meta)
+}
+
+func resourceNetboxSiteGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "dcim/site-groups/", setSiteGroupFields)
+}
+
+func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteGroupUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "dcim/site-groups/", expandSiteGroup(d)); err != nil {
+		return err
+	}
+	return resourceNetboxSiteGroupRead(d, meta)
+}
+
+func resourceNetboxSiteGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxSiteGroupDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "dcim/site-groups/")
+}
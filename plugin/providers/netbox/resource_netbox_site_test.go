@@ -0,0 +1,52 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxSiteConfig = `
+resource "netbox_region" "europe" {
+  name = "terraform-test-europe"
+  slug = "terraform-test-europe"
+}
+
+resource "netbox_region" "france" {
+  name      = "terraform-test-france"
+  slug      = "terraform-test-france"
+  parent_id = "${netbox_region.europe.id}"
+}
+
+resource "netbox_site" "paris" {
+  name      = "terraform-test-paris"
+  slug      = "terraform-test-paris"
+  region_id = "${netbox_region.france.id}"
+  facility  = "PAR1"
+  time_zone = "Europe/Paris"
+  latitude  = 48.8566
+  longitude = 2.3522
+}
+
+data "netbox_site" "paris" {
+  slug = "${netbox_site.paris.slug}"
+}
+`
+
+func TestAccResourceNetboxSite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxSiteConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.paris", "status", "active"),
+					resource.TestCheckResourceAttrPair("netbox_region.france", "parent_id", "netbox_region.europe", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_site.paris", "region_id", "netbox_region.france", "id"),
+					resource.TestCheckResourceAttr("data.netbox_site.paris", "facility", "PAR1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxTag returns the resource structure for the netbox_tag
+// resource, that can be set on most objects by name.
+func resourceNetboxTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTagCreate,
+		Read:   resourceNetboxTagRead,
+		Update: resourceNetboxTagUpdate,
+		Delete: resourceNetboxTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceTagSchema(),
+	}
+}
+
+// expandTag builds the body of the tag create and update requests. Without a
+// color netbox uses its default one.
+func expandTag(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("color"); ok {
+		data["color"] = v.(string)
+	}
+	return data
+}
+
+func resourceNetboxTagCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTagCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "extras/tags/", expandTag(d)); err != nil {
+		return err
+	}
+	return resourceNetboxTagRead(d, meta)
+}
+
+func resourceNetboxTagRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/tags/", setTagFields)
+}
+
+func resourceNetboxTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTagUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/tags/", expandTag(d)); err != nil {
+		return err
+	}
+	return resourceNetboxTagRead(d, meta)
+}
+
+func resourceNetboxTagDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTagDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/tags/")
+}
+
+// setTagFields sets the attributes of a netbox_tag from an API answer.
+func setTagFields(d *schema.ResourceData, meta interface{}, tag map[string]interface{}) {
+	d.Set("name", stringValue(tag["name"]))
+	d.Set("slug", stringValue(tag["slug"]))
+	d.Set("color", stringValue(tag["color"]))
+	d.Set("description", stringValue(tag["description"]))
+}
+
+func bareTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// RGB color in hexadecimal, like "9e9e9e".
+		"color": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Description, netbox 2.9+.
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceTagSchema() map[string]*schema.Schema {
+	s := bareTagSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "color":
+			v.Optional = true
+			v.Computed = true
+			v.ValidateFunc = validateColor
+		case "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// validateColor checks that a value is a RGB color in hexadecimal, without the
+// leading "#".
+func validateColor(v interface{}, k string) (ws []string, errors []error) {
+	if !regexp.MustCompile("^[0-9a-f]{6}$").MatchString(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q must be 6 lowercase hexadecimal digits, got %q", k, v))
+	}
+	return
+}
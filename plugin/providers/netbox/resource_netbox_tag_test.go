@@ -0,0 +1,70 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxTagConfig = `
+resource "netbox_tag" "managed" {
+  name  = "terraform-test-managed"
+  slug  = "terraform-test-managed"
+  color = "4caf50"
+}
+
+resource "netbox_tag" "prod" {
+  name = "terraform-test-prod"
+  slug = "terraform-test-prod"
+}
+
+resource "netbox_prefixes" "tagged" {
+  prefix = "10.103.0.0/24"
+  tags   = ["${netbox_tag.prod.name}", "${netbox_tag.managed.name}"]
+}
+
+resource "netbox_vlans" "tagged" {
+  vid  = 3903
+  name = "terraform-test-tagged"
+  tags = ["${netbox_tag.managed.name}"]
+}
+`
+
+func TestAccResourceNetboxTag(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxTagConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tag.managed", "color", "4caf50"),
+					resource.TestCheckResourceAttr("netbox_prefixes.tagged", "tags.#", "2"),
+					resource.TestCheckResourceAttr("netbox_vlans.tagged", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenTags(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want []string
+	}{
+		{nil, []string{}},
+		{[]interface{}{"a", "b"}, []string{"a", "b"}},
+		{[]interface{}{map[string]interface{}{"id": 1.0, "name": "a", "slug": "a"}}, []string{"a"}},
+	}
+	for _, c := range cases {
+		got := flattenTags(c.in)
+		if len(got) != len(c.want) {
+			t.Fatalf("flattenTags(%v) = %v, want %v", c.in, got, c.want)
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("flattenTags(%v) = %v, want %v", c.in, got, c.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxTenant returns the resource structure for the netbox_tenant
+// resource, usually one per customer.
+func resourceNetboxTenant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTenantCreate,
+		Read:   resourceNetboxTenantRead,
+		Update: resourceNetboxTenantUpdate,
+		Delete: resourceNetboxTenantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceTenantSchema(),
+	}
+}
+
+// expandTenant builds the body of the tenant create and update requests.
+func expandTenant(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"slug":          d.Get("slug").(string),
+		"group":         optionalID(d, "group_id"),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          expandTags(d, meta),
+		"custom_fields": expandCustomFields(d, meta),
+	}
+}
+
+func resourceNetboxTenantCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "tenancy/tenants/", expandTenant(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxTenantRead(d, meta)
+}
+
+func resourceNetboxTenantRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "tenancy/tenants/", setTenantFields)
+}
+
+func resourceNetboxTenantUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "tenancy/tenants/", expandTenant(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxTenantRead(d, meta)
+}
+
+func resourceNetboxTenantDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "tenancy/tenants/")
+}
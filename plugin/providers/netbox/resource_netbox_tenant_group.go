@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxTenantGroup returns the resource structure for the
+// netbox_tenant_group resource.
+func resourceNetboxTenantGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTenantGroupCreate,
+		Read:   resourceNetboxTenantGroupRead,
+		Update: resourceNetboxTenantGroupUpdate,
+		Delete: resourceNetboxTenantGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceTenantGroupSchema(),
+	}
+}
+
+// expandTenantGroup builds the body of the tenant group create and update
+// requests.
+func expandTenantGroup(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"parent":      optionalID(d, "parent_id"),
+		"description": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxTenantGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantGroupCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "tenancy/tenant-groups/", expandTenantGroup(d)); err != nil {
+		return err
+	}
+	return resourceNetboxTenantGroupRead(d, meta)
+}
+
+func resourceNetboxTenantGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "tenancy/tenant-groups/", setTenantGroupFields)
+}
+
+func resourceNetboxTenantGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantGroupUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "tenancy/tenant-groups/", expandTenantGroup(d)); err != nil {
+		return err
+	}
+	return resourceNetboxTenantGroupRead(d, meta)
+}
+
+func resourceNetboxTenantGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxTenantGroupDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "tenancy/tenant-groups/")
+}
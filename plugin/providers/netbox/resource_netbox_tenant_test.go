@@ -0,0 +1,52 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxTenantConfig = `
+resource "netbox_tenant_group" "customers" {
+  name = "terraform-test-customers"
+  slug = "terraform-test-customers"
+}
+
+resource "netbox_tenant" "acme" {
+  name     = "terraform-test-acme"
+  slug     = "terraform-test-acme"
+  group_id = "${netbox_tenant_group.customers.id}"
+}
+
+data "netbox_tenant" "acme" {
+  name = "${netbox_tenant.acme.name}"
+}
+
+resource "netbox_prefixes" "acme" {
+  prefix    = "10.102.0.0/24"
+  tenant_id = "${netbox_tenant.acme.id}"
+}
+
+resource "netbox_vlans" "acme" {
+  vid       = 3902
+  name      = "terraform-test-acme"
+  tenant_id = "${netbox_tenant.acme.id}"
+}
+`
+
+func TestAccResourceNetboxTenant(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_tenant.acme", "group_id", "netbox_tenant_group.customers", "id"),
+					resource.TestCheckResourceAttrPair("netbox_prefixes.acme", "tenant_id", "netbox_tenant.acme", "id"),
+					resource.TestCheckResourceAttrPair("netbox_vlans.acme", "tenant_id", "netbox_tenant.acme", "id"),
+				),
+			},
+		},
+	})
+}
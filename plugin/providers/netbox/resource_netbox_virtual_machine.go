@@ -0,0 +1,76 @@
+package netbox
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxVirtualMachine returns the resource structure for the
+// netbox_virtual_machine resource.
+func resourceNetboxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualMachineCreate,
+		Read:   resourceNetboxVirtualMachineRead,
+		Update: resourceNetboxVirtualMachineUpdate,
+		Delete: resourceNetboxVirtualMachineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceVirtualMachineSchema(),
+	}
+}
+
+// expandVirtualMachine builds the body of the virtual machine create and
+// update requests.
+func expandVirtualMachine(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":               d.Get("name").(string),
+		"cluster":            optionalID(d, "cluster_id"),
+		"role":               optionalID(d, "role_id"),
+		"platform":           optionalID(d, "platform_id"),
+		"tenant":             optionalID(d, "tenant_id"),
+		"status":             expandChoice(meta, d.Get("status").(string), virtualMachineStatusLegacy),
+		"vcpus":              optionalFloat(d, "vcpus"),
+		"memory":             optionalInt(d, "memory"),
+		"disk":               optionalInt(d, "disk"),
+		"local_context_data": expandJSON(d, "local_context_data"),
+		"comments":           d.Get("comments").(string),
+		"tags":               expandTags(d, meta),
+		"custom_fields":      expandCustomFields(d, meta),
+	}
+	for _, key := range []string{"site_id", "primary_ip4_id", "primary_ip6_id"} {
+		if v, ok := d.GetOk(key); ok {
+			data[strings.TrimSuffix(key, "_id")] = v.(int)
+		}
+	}
+	return data
+}
+
+func resourceNetboxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVirtualMachineCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "virtualization/virtual-machines/", expandVirtualMachine(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxVirtualMachineRead(d, meta)
+}
+
+func resourceNetboxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "virtualization/virtual-machines/", setVirtualMachineFields)
+}
+
+func resourceNetboxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVirtualMachineUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "virtualization/virtual-machines/", expandVirtualMachine(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxVirtualMachineRead(d, meta)
+}
+
+func resourceNetboxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVirtualMachineDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "virtualization/virtual-machines/")
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxVirtualMachineConfig = `
+resource "netbox_cluster_type" "vmware" {
+  name = "terraform-test-vmware"
+  slug = "terraform-test-vmware"
+}
+
+resource "netbox_cluster_group" "prod" {
+  name = "terraform-test-prod"
+  slug = "terraform-test-prod"
+}
+
+resource "netbox_cluster" "c1" {
+  name     = "terraform-test-c1"
+  type_id  = "${netbox_cluster_type.vmware.id}"
+  group_id = "${netbox_cluster_group.prod.id}"
+}
+
+resource "netbox_virtual_machine" "vm1" {
+  name       = "terraform-test-vm1"
+  cluster_id = "${netbox_cluster.c1.id}"
+  vcpus      = 2
+  memory     = 4096
+  disk       = 40
+
+  local_context_data = <<EOT
+{"ntp": ["10.0.0.1"]}
+EOT
+}
+
+resource "netbox_vm_interface" "eth0" {
+  virtual_machine_id = "${netbox_virtual_machine.vm1.id}"
+  name               = "eth0"
+}
+
+data "netbox_virtual_machine" "vm1" {
+  name       = "${netbox_virtual_machine.vm1.name}"
+  cluster_id = "${netbox_cluster.c1.id}"
+}
+`
+
+func TestAccResourceNetboxVirtualMachine(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxVirtualMachineConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_machine.vm1", "memory", "4096"),
+					resource.TestCheckResourceAttrPair("netbox_vm_interface.eth0", "virtual_machine_id", "netbox_virtual_machine.vm1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_machine.vm1", "local_context_data", `{"ntp":["10.0.0.1"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	if !suppressEquivalentJSON("local_context_data", `{"a": [1, 2], "b": "c"}`, `{"b":"c","a":[1,2]}`, nil) {
+		t.Error("equivalent JSON documents should not cause a diff")
+	}
+	if suppressEquivalentJSON("local_context_data", `{"a": 1}`, `{"a": 2}`, nil) {
+		t.Error("different JSON documents should cause a diff")
+	}
+}
+
+func TestClusterSiteID(t *testing.T) {
+	cases := []struct {
+		cluster map[string]interface{}
+		want    int
+	}{
+		{map[string]interface{}{"site": map[string]interface{}{"id": 1.0}}, 1},
+		{map[string]interface{}{"scope_type": "dcim.site", "scope_id": 2.0}, 2},
+		{map[string]interface{}{"scope_type": "dcim.region", "scope_id": 3.0}, 0},
+		{map[string]interface{}{"scope_type": nil, "scope_id": nil}, 0},
+	}
+	for _, c := range cases {
+		if got := clusterSiteID(c.cluster); got != c.want {
+			t.Errorf("clusterSiteID(%v) = %d, want %d", c.cluster, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxVlanGroup returns the resource structure for the
+// netbox_vlan_group resource.
+func resourceNetboxVlanGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVlanGroupCreate,
+		Read:   resourceNetboxVlanGroupRead,
+		Update: resourceNetboxVlanGroupUpdate,
+		Delete: resourceNetboxVlanGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceVlanGroupSchema(),
+	}
+}
+
+func bareVlanGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"slug": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"site_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Scope of the group, like "dcim.site" or "dcim.region", netbox 2.11+.
+		"scope_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"scope_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// Range of the VLAN IDs of the group, on the netbox versions that
+		// support it.
+		"min_vid": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"max_vid": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceVlanGroupSchema() map[string]*schema.Schema {
+	s := bareVlanGroupSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "slug":
+			v.Required = true
+		case "site_id":
+			v.Optional = true
+			v.Computed = true
+			v.ConflictsWith = []string{"scope_type", "scope_id"}
+		case "scope_type", "scope_id":
+			v.Optional = true
+			v.Computed = true
+		case "min_vid", "max_vid":
+			v.Optional = true
+			v.Computed = true
+			v.ValidateFunc = validateVid
+		case "description":
+			v.Optional = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
+
+// validateVid checks that a value is a valid VLAN ID.
+func validateVid(v interface{}, k string) (ws []string, errors []error) {
+	if vid := v.(int); vid < 1 || vid > 4094 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 4094, got %d", k, vid))
+	}
+	return
+}
+
+// expandVlanGroup builds the body of the VLAN group create and update
+// requests. Since netbox 2.11 the site of a group is one of its scopes.
+func expandVlanGroup(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+	}
+	if netboxVersionAtLeast(meta, "2.11") {
+		// site_id and the scope are computed from each other, so the scope
+		// read from netbox must not win over a changed site_id.
+		siteChanged := d.HasChange("site_id") && !d.HasChange("scope_type") && !d.HasChange("scope_id")
+		switch {
+		case d.Get("scope_type").(string) != "" && !siteChanged:
+			data["scope_type"] = d.Get("scope_type").(string)
+			data["scope_id"] = optionalID(d, "scope_id")
+		case d.Get("site_id").(int) != 0:
+			data["scope_type"] = "dcim.site"
+			data["scope_id"] = d.Get("site_id").(int)
+		default:
+			data["scope_type"] = nil
+			data["scope_id"] = nil
+		}
+	} else {
+		data["site"] = optionalID(d, "site_id")
+	}
+	if v, ok := d.GetOk("min_vid"); ok {
+		data["min_vid"] = v.(int)
+	}
+	if v, ok := d.GetOk("max_vid"); ok {
+		data["max_vid"] = v.(int)
+	}
+	return data
+}
+
+// setVlanGroupFields sets the attributes of a netbox_vlan_group from an API
+// answer.
+func setVlanGroupFields(d *schema.ResourceData, meta interface{}, group map[string]interface{}) {
+	d.Set("name", stringValue(group["name"]))
+	d.Set("slug", stringValue(group["slug"]))
+	d.Set("description", stringValue(group["description"]))
+	if scopeType, ok := group["scope_type"]; ok {
+		d.Set("scope_type", stringValue(scopeType))
+		d.Set("scope_id", intValue(group["scope_id"]))
+		if stringValue(scopeType) == "dcim.site" {
+			d.Set("site_id", intValue(group["scope_id"]))
+		} else {
+			d.Set("site_id", 0)
+		}
+	} else {
+		d.Set("site_id", nestedID(group["site"]))
+	}
+	// Older versions do not know the VID range, the configuration is kept.
+	if v, ok := group["min_vid"]; ok {
+		d.Set("min_vid", intValue(v))
+	}
+	if v, ok := group["max_vid"]; ok {
+		d.Set("max_vid", intValue(v))
+	}
+}
+
+func resourceNetboxVlanGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVlanGroupCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "ipam/vlan-groups/", expandVlanGroup(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxVlanGroupRead(d, meta)
+}
+
+func resourceNetboxVlanGroupRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/vlan-groups/", setVlanGroupFields)
+}
+
+func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVlanGroupUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "ipam/vlan-groups/", expandVlanGroup(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxVlanGroupRead(d, meta)
+}
+
+func resourceNetboxVlanGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVlanGroupDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/vlan-groups/")
+}
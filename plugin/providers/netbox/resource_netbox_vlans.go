@@ -1,29 +1,29 @@
 package netbox
 
 import (
-	// "errors"
+	"errors"
 	// "fmt"
-	// "strconv"
 	"log"
+	"strconv"
 
-	"github.com/digitalocean/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// resourceNetboxAddress returns the resource structure for the netbox_address
+// resourceNetboxVlans returns the resource structure for the netbox_vlans
 // resource.
 //
-// Note that we use the data source read function here to pull down data, as
-// read workflow is identical for both the resource and the data source.
+// Note that the resource reads the VLAN by its ID, as the vid and the name
+// the data source searches by are not unique across VLAN groups.
 func resourceNetboxVlans() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxVlansCreate,
-		Read:   dataSourceNetboxVlansRead,
+		Read:   resourceNetboxVlansRead,
 		Update: resourceNetboxVlansUpdate,
 		Delete: resourceNetboxVlansDelete,
 		Exists: resourceNetboxVlansExists,
-
 		Schema: resourceVlansSchema(),
+
+		CustomizeDiff: validateCustomFields,
 	}
 }
 
@@ -37,34 +37,69 @@ func resourceNetboxVlansExists(d *schema.ResourceData, meta interface{}) (b bool
 // If the API you are using doesn’t provide an ID, you can always use a random Int.
 func resourceNetboxVlansCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxVlansCreate: %v\n", d)
-	c := meta.(*ProviderNetboxClient).client
-	var parm = ipam.NewIPAMVlansCreateParams()
-	log.Println("Criei o parm")
-	parm.Data.ID = int64(d.Get("prefixes_id").(int))
-	//parm.Set("ID", int64(d.Get("prefixes_id").(int)))
-	//parm.SetCreated(d.Get("prefixes_created"))
-	log.Println("Setei o parm")
+	if d.Get("vid").(int) == 0 || d.Get("name").(string) == "" {
+		return errors.New("vid and name must be informed")
+	}
+	if err := netboxObjectCreate(d, meta, "ipam/vlans/", expandVlans(d, meta)); err != nil {
+		log.Printf("erro na chamada do POST vlans\n")
+		log.Printf("Err: %v\n", err)
+		return err
+	}
+	return resourceNetboxVlansRead(d, meta)
+}
 
-	//parms = ipam.NewIPAMPrefixesListParams()
-	out, err := c.IPAM.IPAMVlansCreate(parm, nil)
-	log.Printf("- Executado...\n")
-	print("out %v\n", out)
-	print("err %v\n", err)
+// expandVlans builds the body of the VLAN create and update requests.
+func expandVlans(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"vid":           d.Get("vid").(int),
+		"name":          d.Get("name").(string),
+		"role":          optionalID(d, "role_id"),
+		"tenant":        optionalID(d, "tenant_id"),
+		"custom_fields": expandCustomFields(d, meta),
+		"tags":          expandTags(d, meta),
+	}
+	if v, ok := d.GetOk("status"); ok {
+		data["status"] = expandChoice(meta, v.(string), vlanStatusLegacy)
+	}
+	return data
+}
+
+// setVlansFields sets the attributes of a netbox_vlans from an API answer.
+func setVlansFields(d *schema.ResourceData, meta interface{}, vlan map[string]interface{}) {
+	// site_id and group_id are strings, shared with the filters.
+	idString := func(v interface{}) string {
+		if id := nestedID(v); id != 0 {
+			return strconv.Itoa(id)
+		}
+		return ""
+	}
+	d.Set("vid", intValue(vlan["vid"]))
+	d.Set("name", stringValue(vlan["name"]))
+	d.Set("status", flattenChoice(vlan["status"], vlanStatusLegacy))
+	d.Set("role_id", nestedID(vlan["role"]))
+	d.Set("site_id", idString(vlan["site"]))
+	d.Set("group_id", idString(vlan["group"]))
+	d.Set("tenant_id", nestedID(vlan["tenant"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, vlan["custom_fields"]))
+	d.Set("tags", flattenTags(vlan["tags"]))
+}
 
-	return nil
+func resourceNetboxVlansRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/vlans/", setVlansFields)
 }
 
-//Update is optional if your Resource doesn’t support update.
-//For example, I’m not using update in the Terraform LDAP Provider.
-//I just destroy and recreate the resource everytime there is a change.
+// Update is optional if your Resource doesn’t support update.
+// For example, I’m not using update in the Terraform LDAP Provider.
+// I just destroy and recreate the resource everytime there is a change.
 func resourceNetboxVlansUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxVlansUpdate: %v\n", d)
-
-	return nil
+	if err := netboxObjectUpdate(d, meta, "ipam/vlans/", expandVlans(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxVlansRead(d, meta)
 }
 
 func resourceNetboxVlansDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] JP resourceNetboxVlansDelete: %v\n", d)
-	d.SetId("")
-	return nil
+	return netboxObjectDelete(d, meta, "ipam/vlans/")
 }
@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxVMInterface returns the resource structure for the
+// netbox_vm_interface resource, an interface of a virtual machine.
+func resourceNetboxVMInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVMInterfaceCreate,
+		Read:   resourceNetboxVMInterfaceRead,
+		Update: resourceNetboxVMInterfaceUpdate,
+		Delete: resourceNetboxVMInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceVMInterfaceSchema(),
+	}
+}
+
+// expandVMInterface builds the body of the VM interface create and update
+// requests.
+func expandVMInterface(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"virtual_machine": optionalID(d, "virtual_machine_id"),
+		"name":            d.Get("name").(string),
+		"enabled":         d.Get("enabled").(bool),
+		"mtu":             optionalInt(d, "mtu"),
+		"mac_address":     optionalString(d, "mac_address"),
+		"mode":            optionalChoice(d, meta, "mode", interfaceModeLegacy),
+		"untagged_vlan":   optionalID(d, "untagged_vlan_id"),
+		"tagged_vlans":    expandIDSet(d, "tagged_vlan_ids"),
+		"parent":          optionalID(d, "parent_id"),
+		"description":     d.Get("description").(string),
+		"tags":            expandTags(d, meta),
+		"custom_fields":   expandCustomFields(d, meta),
+	}
+	// The MAC address of the interface is read only on netbox 4.2+, see
+	// setInterfaceMACAddress.
+	if netboxVersionAtLeast(meta, "4.2") {
+		delete(data, "mac_address")
+	}
+	return data
+}
+
+func resourceNetboxVMInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVMInterfaceCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "virtualization/interfaces/", expandVMInterface(d, meta)); err != nil {
+		return err
+	}
+	if err := setInterfaceMACAddress(d, meta, "virtualization/interfaces/"); err != nil {
+		return err
+	}
+	return resourceNetboxVMInterfaceRead(d, meta)
+}
+
+func resourceNetboxVMInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "virtualization/interfaces/", setVMInterfaceFields)
+}
+
+func resourceNetboxVMInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVMInterfaceUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "virtualization/interfaces/", expandVMInterface(d, meta)); err != nil {
+		return err
+	}
+	if err := setInterfaceMACAddress(d, meta, "virtualization/interfaces/"); err != nil {
+		return err
+	}
+	return resourceNetboxVMInterfaceRead(d, meta)
+}
+
+func resourceNetboxVMInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVMInterfaceDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "virtualization/interfaces/")
+}
+
+// setVMInterfaceFields sets the attributes of a netbox_vm_interface from an
+// API answer.
+func setVMInterfaceFields(d *schema.ResourceData, meta interface{}, iface map[string]interface{}) {
+	d.Set("virtual_machine_id", nestedID(iface["virtual_machine"]))
+	d.Set("name", stringValue(iface["name"]))
+	d.Set("enabled", boolValue(iface["enabled"]))
+	d.Set("mtu", intValue(iface["mtu"]))
+	d.Set("mac_address", stringValue(iface["mac_address"]))
+	d.Set("mode", flattenChoice(iface["mode"], interfaceModeLegacy))
+	d.Set("untagged_vlan_id", nestedID(iface["untagged_vlan"]))
+	d.Set("tagged_vlan_ids", flattenIDList(iface["tagged_vlans"]))
+	d.Set("parent_id", nestedID(iface["parent"]))
+	d.Set("description", stringValue(iface["description"]))
+	d.Set("tags", flattenTags(iface["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, iface["custom_fields"]))
+}
+
+func bareVMInterfaceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"virtual_machine_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"enabled": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		"mtu": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		// MAC address. On netbox 4.2+ it is the primary MAC address object of
+		// the interface.
+		"mac_address": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// 802.1Q mode, needed by the VLANs.
+		"mode": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"untagged_vlan_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"tagged_vlan_ids": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeInt},
+		},
+		// Parent interface, netbox 3.0+.
+		"parent_id": &schema.Schema{
+			Type: schema.TypeInt,
+		},
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceVMInterfaceSchema() map[string]*schema.Schema {
+	s := bareVMInterfaceSchema()
+	for k, v := range s {
+		switch k {
+		case "virtual_machine_id":
+			v.Required = true
+			v.ForceNew = true
+		case "name":
+			v.Required = true
+		case "enabled":
+			v.Optional = true
+			v.Default = true
+		case "mtu":
+			v.Optional = true
+			v.ValidateFunc = validation.IntBetween(1, 65536)
+		case "mac_address", "untagged_vlan_id", "tagged_vlan_ids", "parent_id", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "mode":
+			v.Optional = true
+			v.ValidateFunc = validation.StringInSlice([]string{"access", "tagged", "tagged-all"}, false)
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
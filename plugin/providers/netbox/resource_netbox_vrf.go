@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceNetboxVrf returns the resource structure for the netbox_vrf
+// resource.
+func resourceNetboxVrf() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVrfCreate,
+		Read:   resourceNetboxVrfRead,
+		Update: resourceNetboxVrfUpdate,
+		Delete: resourceNetboxVrfDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateCustomFields,
+
+		Schema: resourceVrfSchema(),
+	}
+}
+
+// expandVrf builds the body of the VRF create and update requests.
+func expandVrf(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"rd":             nil,
+		"tenant":         optionalID(d, "tenant_id"),
+		"enforce_unique": d.Get("enforce_unique").(bool),
+		"description":    d.Get("description").(string),
+		"tags":           expandTags(d, meta),
+		"custom_fields":  expandCustomFields(d, meta),
+	}
+	if v, ok := d.GetOk("rd"); ok {
+		data["rd"] = v.(string)
+	}
+	importTargets := expandIDSet(d, "import_targets")
+	exportTargets := expandIDSet(d, "export_targets")
+	if netboxVersionAtLeast(meta, "2.10") {
+		data["import_targets"] = importTargets
+		data["export_targets"] = exportTargets
+	} else if len(importTargets) > 0 || len(exportTargets) > 0 {
+		return nil, errors.New("import_targets and export_targets need netbox 2.10 or newer")
+	}
+	return data, nil
+}
+
+func resourceNetboxVrfCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVrfCreate: %v\n", d.Get("name"))
+	data, err := expandVrf(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := netboxObjectCreate(d, meta, "ipam/vrfs/", data); err != nil {
+		return err
+	}
+	return resourceNetboxVrfRead(d, meta)
+}
+
+func resourceNetboxVrfRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "ipam/vrfs/", setVrfFields)
+}
+
+func resourceNetboxVrfUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVrfUpdate: %v\n", d.Id())
+	data, err := expandVrf(d, meta)
+	if err != nil {
+		return err
+	}
+	if err := netboxObjectUpdate(d, meta, "ipam/vrfs/", data); err != nil {
+		return err
+	}
+	return resourceNetboxVrfRead(d, meta)
+}
+
+func resourceNetboxVrfDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxVrfDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "ipam/vrfs/")
+}
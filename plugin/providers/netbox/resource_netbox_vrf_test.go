@@ -0,0 +1,42 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccResourceNetboxVrfConfig = `
+resource "netbox_vrf" "vrf" {
+  name        = "terraform-test-vrf"
+  rd          = "65000:100"
+  description = "Terraform test VRF"
+}
+
+data "netbox_vrf" "vrf_by_rd" {
+  rd = "${netbox_vrf.vrf.rd}"
+}
+
+resource "netbox_prefixes" "prefix" {
+  prefix      = "10.100.0.0/24"
+  description = "Terraform test prefix"
+  vrf_id      = "${netbox_vrf.vrf.id}"
+}
+`
+
+func TestAccResourceNetboxVrf(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccResourceNetboxVrfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.vrf", "enforce_unique", "true"),
+					resource.TestCheckResourceAttrPair("data.netbox_vrf.vrf_by_rd", "name", "netbox_vrf.vrf", "name"),
+					resource.TestCheckResourceAttrPair("netbox_prefixes.prefix", "vrf_id", "netbox_vrf.vrf", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// resourceNetboxWebhook returns the resource structure for the netbox_webhook
+// resource, an HTTP request sent by netbox on the changes of objects.
+func resourceNetboxWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxWebhookCreate,
+		Read:   resourceNetboxWebhookRead,
+		Update: resourceNetboxWebhookUpdate,
+		Delete: resourceNetboxWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceNetboxWebhookCustomizeDiff,
+
+		Schema: resourceWebhookSchema(),
+	}
+}
+
+// resourceNetboxWebhookCustomizeDiff checks at plan time that the models and
+// the events of the webhook are set before netbox 3.7, and only before it,
+// and that its tags and custom fields are only set on netbox 3.7+.
+func resourceNetboxWebhookCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateCustomFields(d, meta); err != nil {
+		return err
+	}
+	if !netboxVersionAtLeast(meta, "3.7") && d.NewValueKnown("tags") && d.NewValueKnown("custom_fields") {
+		if d.Get("tags").(*schema.Set).Len() > 0 || len(d.Get("custom_fields").(map[string]interface{})) > 0 {
+			return errors.New("tags and custom_fields of webhooks need netbox 3.7+")
+		}
+	}
+	if !d.NewValueKnown("content_types") || !d.NewValueKnown("events") {
+		return nil
+	}
+	set := d.Get("content_types").(*schema.Set).Len() > 0 || d.Get("events").(*schema.Set).Len() > 0
+	switch {
+	case netboxVersionAtLeast(meta, "3.7") && set:
+		return errors.New("content_types and events are set with a netbox_event_rule on netbox 3.7+")
+	case !netboxVersionAtLeast(meta, "3.7") && !set:
+		return errors.New("content_types and events are required before netbox 3.7")
+	}
+	return nil
+}
+
+// expandWebhook builds the body of the webhook create and update requests.
+func expandWebhook(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":               d.Get("name").(string),
+		"payload_url":        d.Get("payload_url").(string),
+		"http_method":        d.Get("http_method").(string),
+		"http_content_type":  d.Get("http_content_type").(string),
+		"additional_headers": d.Get("additional_headers").(string),
+		"body_template":      d.Get("body_template").(string),
+		"secret":             d.Get("secret").(string),
+		"ssl_verification":   d.Get("ssl_verification").(bool),
+		"ca_file_path":       d.Get("ca_file_path").(string),
+		"description":        d.Get("description").(string),
+	}
+	if !netboxVersionAtLeast(meta, "3.7") {
+		data["content_types"] = expandStringList(d, "content_types")
+		data["enabled"] = d.Get("enabled").(bool)
+		expandEvents(d, meta, data)
+		return data
+	}
+	data["tags"] = expandTags(d, meta)
+	data["custom_fields"] = expandCustomFields(d, meta)
+	return data
+}
+
+func resourceNetboxWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxWebhookCreate: %v\n", d.Get("name"))
+	if err := netboxObjectCreate(d, meta, "extras/webhooks/", expandWebhook(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxWebhookRead(d, meta)
+}
+
+func resourceNetboxWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	return netboxObjectRead(d, meta, "extras/webhooks/", setWebhookFields)
+}
+
+func resourceNetboxWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxWebhookUpdate: %v\n", d.Id())
+	if err := netboxObjectUpdate(d, meta, "extras/webhooks/", expandWebhook(d, meta)); err != nil {
+		return err
+	}
+	return resourceNetboxWebhookRead(d, meta)
+}
+
+func resourceNetboxWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] resourceNetboxWebhookDelete: %v\n", d.Id())
+	return netboxObjectDelete(d, meta, "extras/webhooks/")
+}
+
+// setWebhookFields sets the attributes of a netbox_webhook from an API answer.
+func setWebhookFields(d *schema.ResourceData, meta interface{}, webhook map[string]interface{}) {
+	d.Set("name", stringValue(webhook["name"]))
+	d.Set("content_types", flattenStringList(webhook["content_types"]))
+	d.Set("events", flattenEvents(webhook))
+	if v, ok := webhook["enabled"]; ok {
+		d.Set("enabled", boolValue(v))
+	}
+	d.Set("payload_url", stringValue(webhook["payload_url"]))
+	d.Set("http_method", stringValue(webhook["http_method"]))
+	d.Set("http_content_type", stringValue(webhook["http_content_type"]))
+	d.Set("additional_headers", stringValue(webhook["additional_headers"]))
+	d.Set("body_template", stringValue(webhook["body_template"]))
+	d.Set("secret", stringValue(webhook["secret"]))
+	d.Set("ssl_verification", boolValue(webhook["ssl_verification"]))
+	d.Set("ca_file_path", stringValue(webhook["ca_file_path"]))
+	d.Set("description", stringValue(webhook["description"]))
+	d.Set("tags", flattenTags(webhook["tags"]))
+	d.Set("custom_fields", flattenCustomFields(d, meta, webhook["custom_fields"]))
+}
+
+func bareWebhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Models and events of the webhook, like "ipam.prefix" and "create",
+		// before netbox 3.7. Set with a netbox_event_rule after it.
+		"content_types": &schema.Schema{
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{Type: schema.TypeString},
+			Set:  schema.HashString,
+		},
+		"events": eventsSchema(),
+		// Netbox before 3.7.
+		"enabled": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// URL called by the webhook. It is a Jinja2 template on netbox 3.7+.
+		"payload_url": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"http_method": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"http_content_type": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Additional headers, one "Name: Value" per line.
+		"additional_headers": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Jinja2 template of the body. The default body is the JSON event.
+		"body_template": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Key of the HMAC signature of the body, sent as X-Hook-Signature.
+		"secret": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"ssl_verification": &schema.Schema{
+			Type: schema.TypeBool,
+		},
+		// CA certificate of the server, on the netbox host.
+		"ca_file_path": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		// Netbox 3.7+, as the tags and the custom fields.
+		"description": &schema.Schema{
+			Type: schema.TypeString,
+		},
+		"tags":          tagsSchema(),
+		"custom_fields": customFieldsSchema(),
+	}
+}
+
+func resourceWebhookSchema() map[string]*schema.Schema {
+	s := bareWebhookSchema()
+	for k, v := range s {
+		switch k {
+		case "name", "payload_url":
+			v.Required = true
+		case "content_types", "events", "body_template", "ca_file_path", "description", "tags", "custom_fields":
+			v.Optional = true
+		case "enabled", "ssl_verification":
+			v.Optional = true
+			v.Default = true
+		case "http_method":
+			v.Optional = true
+			v.Default = "POST"
+			v.ValidateFunc = validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, false)
+		case "http_content_type":
+			v.Optional = true
+			v.Default = "application/json"
+		// The headers often hold credentials, like an Authorization header.
+		case "secret", "additional_headers":
+			v.Optional = true
+			v.Sensitive = true
+		default:
+			v.Computed = true
+		}
+	}
+	return s
+}
@@ -0,0 +1,54 @@
+package netbox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tagsSchema returns the bare schema of the tags attribute. Tags are a set of
+// tag names, so the order returned by netbox never causes a diff.
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{Type: schema.TypeString},
+		Set:  schema.HashString,
+	}
+}
+
+// expandTags converts the tags attribute into the representation expected by
+// the netbox version: a list of names before 2.9, and a list of nested tag
+// objects after it.
+func expandTags(d *schema.ResourceData, meta interface{}) interface{} {
+	names := []string{}
+	if v, ok := d.GetOk("tags"); ok {
+		for _, t := range v.(*schema.Set).List() {
+			names = append(names, t.(string))
+		}
+	}
+	if !netboxVersionAtLeast(meta, "2.9") {
+		return names
+	}
+	tags := []map[string]interface{}{}
+	for _, name := range names {
+		tags = append(tags, map[string]interface{}{"name": name})
+	}
+	return tags
+}
+
+// flattenTags converts the tags of an API answer, in any of the netbox
+// representations, into a list of tag names.
+func flattenTags(v interface{}) []string {
+	names := []string{}
+	l, ok := v.([]interface{})
+	if !ok {
+		return names
+	}
+	for _, t := range l {
+		switch tag := t.(type) {
+		case string:
+			names = append(names, tag)
+		case map[string]interface{}:
+			names = append(names, stringValue(tag["name"]))
+		}
+	}
+	return names
+}